@@ -1,14 +1,16 @@
 package ante
 
 import (
-	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type PendingTxListener func(common.Hash)
+// PendingTxListener is notified of the Ethereum transactions entering the
+// mempool.
+type PendingTxListener func(*ethtypes.Transaction)
 
 type TxListenerDecorator struct {
 	pendingTxListener PendingTxListener
@@ -27,7 +29,7 @@ func (d TxListenerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate boo
 	if ctx.IsCheckTx() && !simulate && d.pendingTxListener != nil {
 		for _, msg := range tx.GetMsgs() {
 			if ethTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
-				d.pendingTxListener(ethTx.Hash())
+				d.pendingTxListener(ethTx.AsTransaction())
 			}
 		}
 	}
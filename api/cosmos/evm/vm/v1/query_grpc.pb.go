@@ -27,6 +27,7 @@ const (
 	Query_Code_FullMethodName              = "/cosmos.evm.vm.v1.Query/Code"
 	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
 	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
+	Query_SimulateV1_FullMethodName        = "/cosmos.evm.vm.v1.Query/SimulateV1"
 	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
 	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
 	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
@@ -34,6 +35,14 @@ const (
 	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
 	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
 	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
+	Query_ContractABIs_FullMethodName      = "/cosmos.evm.vm.v1.Query/ContractABIs"
+	Query_ContractABI_FullMethodName       = "/cosmos.evm.vm.v1.Query/ContractABI"
+	Query_ForkSchedules_FullMethodName     = "/cosmos.evm.vm.v1.Query/ForkSchedules"
+	Query_CodeMetadata_FullMethodName      = "/cosmos.evm.vm.v1.Query/CodeMetadata"
+	Query_ContractCreation_FullMethodName  = "/cosmos.evm.vm.v1.Query/ContractCreation"
+	Query_Preinstalls_FullMethodName       = "/cosmos.evm.vm.v1.Query/Preinstalls"
+	Query_StorageRange_FullMethodName      = "/cosmos.evm.vm.v1.Query/StorageRange"
+	Query_AccountRange_FullMethodName      = "/cosmos.evm.vm.v1.Query/AccountRange"
 )
 
 // QueryClient is the client API for Query service.
@@ -58,6 +67,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
@@ -78,6 +89,25 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// ContractABIs queries all contract ABIs registered for typed event
+	// emission.
+	ContractABIs(ctx context.Context, in *QueryContractABIsRequest, opts ...grpc.CallOption) (*QueryContractABIsResponse, error)
+	// ContractABI queries the contract ABI registered for the given address.
+	ContractABI(ctx context.Context, in *QueryContractABIRequest, opts ...grpc.CallOption) (*QueryContractABIResponse, error)
+	// ForkSchedules queries the Ethereum hard forks scheduled through governance.
+	ForkSchedules(ctx context.Context, in *QueryForkSchedulesRequest, opts ...grpc.CallOption) (*QueryForkSchedulesResponse, error)
+	// CodeMetadata queries the verified deployment metadata of a contract code.
+	CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error)
+	// ContractCreation queries the creator, creation transaction and height of a
+	// contract.
+	ContractCreation(ctx context.Context, in *QueryContractCreationRequest, opts ...grpc.CallOption) (*QueryContractCreationResponse, error)
+	// Preinstalls queries the installed preinstalled contracts together with
+	// their version, source and code hash metadata.
+	Preinstalls(ctx context.Context, in *QueryPreinstallsRequest, opts ...grpc.CallOption) (*QueryPreinstallsResponse, error)
+	// StorageRange implements the `debug_storageRangeAt` rpc api
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// AccountRange implements the `debug_accountRange` rpc api
+	AccountRange(ctx context.Context, in *QueryAccountRangeRequest, opts ...grpc.CallOption) (*QueryAccountRangeResponse, error)
 }
 
 type queryClient struct {
@@ -160,6 +190,15 @@ func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, Query_SimulateV1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
 	out := new(EstimateGasResponse)
 	err := c.cc.Invoke(ctx, Query_EstimateGas_FullMethodName, in, out, opts...)
@@ -223,6 +262,78 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) ContractABIs(ctx context.Context, in *QueryContractABIsRequest, opts ...grpc.CallOption) (*QueryContractABIsResponse, error) {
+	out := new(QueryContractABIsResponse)
+	err := c.cc.Invoke(ctx, Query_ContractABIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractABI(ctx context.Context, in *QueryContractABIRequest, opts ...grpc.CallOption) (*QueryContractABIResponse, error) {
+	out := new(QueryContractABIResponse)
+	err := c.cc.Invoke(ctx, Query_ContractABI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForkSchedules(ctx context.Context, in *QueryForkSchedulesRequest, opts ...grpc.CallOption) (*QueryForkSchedulesResponse, error) {
+	out := new(QueryForkSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_ForkSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error) {
+	out := new(QueryCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_CodeMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractCreation(ctx context.Context, in *QueryContractCreationRequest, opts ...grpc.CallOption) (*QueryContractCreationResponse, error) {
+	out := new(QueryContractCreationResponse)
+	err := c.cc.Invoke(ctx, Query_ContractCreation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Preinstalls(ctx context.Context, in *QueryPreinstallsRequest, opts ...grpc.CallOption) (*QueryPreinstallsResponse, error) {
+	out := new(QueryPreinstallsResponse)
+	err := c.cc.Invoke(ctx, Query_Preinstalls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, Query_StorageRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountRange(ctx context.Context, in *QueryAccountRangeRequest, opts ...grpc.CallOption) (*QueryAccountRangeResponse, error) {
+	out := new(QueryAccountRangeResponse)
+	err := c.cc.Invoke(ctx, Query_AccountRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -245,6 +356,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
@@ -265,6 +378,25 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// ContractABIs queries all contract ABIs registered for typed event
+	// emission.
+	ContractABIs(context.Context, *QueryContractABIsRequest) (*QueryContractABIsResponse, error)
+	// ContractABI queries the contract ABI registered for the given address.
+	ContractABI(context.Context, *QueryContractABIRequest) (*QueryContractABIResponse, error)
+	// ForkSchedules queries the Ethereum hard forks scheduled through governance.
+	ForkSchedules(context.Context, *QueryForkSchedulesRequest) (*QueryForkSchedulesResponse, error)
+	// CodeMetadata queries the verified deployment metadata of a contract code.
+	CodeMetadata(context.Context, *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error)
+	// ContractCreation queries the creator, creation transaction and height of a
+	// contract.
+	ContractCreation(context.Context, *QueryContractCreationRequest) (*QueryContractCreationResponse, error)
+	// Preinstalls queries the installed preinstalled contracts together with
+	// their version, source and code hash metadata.
+	Preinstalls(context.Context, *QueryPreinstallsRequest) (*QueryPreinstallsResponse, error)
+	// StorageRange implements the `debug_storageRangeAt` rpc api
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// AccountRange implements the `debug_accountRange` rpc api
+	AccountRange(context.Context, *QueryAccountRangeRequest) (*QueryAccountRangeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -296,6 +428,9 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
 }
+func (UnimplementedQueryServer) SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
@@ -317,6 +452,30 @@ func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*Q
 func (UnimplementedQueryServer) GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (UnimplementedQueryServer) ContractABIs(context.Context, *QueryContractABIsRequest) (*QueryContractABIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractABIs not implemented")
+}
+func (UnimplementedQueryServer) ContractABI(context.Context, *QueryContractABIRequest) (*QueryContractABIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractABI not implemented")
+}
+func (UnimplementedQueryServer) ForkSchedules(context.Context, *QueryForkSchedulesRequest) (*QueryForkSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkSchedules not implemented")
+}
+func (UnimplementedQueryServer) CodeMetadata(context.Context, *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeMetadata not implemented")
+}
+func (UnimplementedQueryServer) ContractCreation(context.Context, *QueryContractCreationRequest) (*QueryContractCreationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCreation not implemented")
+}
+func (UnimplementedQueryServer) Preinstalls(context.Context, *QueryPreinstallsRequest) (*QueryPreinstallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preinstalls not implemented")
+}
+func (UnimplementedQueryServer) StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (UnimplementedQueryServer) AccountRange(context.Context, *QueryAccountRangeRequest) (*QueryAccountRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRange not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -474,6 +633,24 @@ func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateV1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +777,150 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractABIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractABIsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractABIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractABIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractABIs(ctx, req.(*QueryContractABIsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractABIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractABI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractABI(ctx, req.(*QueryContractABIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForkSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForkSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForkSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ForkSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForkSchedules(ctx, req.(*QueryForkSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CodeMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeMetadata(ctx, req.(*QueryCodeMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCreation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractCreationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCreation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractCreation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCreation(ctx, req.(*QueryContractCreationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Preinstalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreinstallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Preinstalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Preinstalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Preinstalls(ctx, req.(*QueryPreinstallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRange(ctx, req.(*QueryAccountRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -639,6 +960,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EthCall",
 			Handler:    _Query_EthCall_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
@@ -667,6 +992,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "ContractABIs",
+			Handler:    _Query_ContractABIs_Handler,
+		},
+		{
+			MethodName: "ContractABI",
+			Handler:    _Query_ContractABI_Handler,
+		},
+		{
+			MethodName: "ForkSchedules",
+			Handler:    _Query_ForkSchedules_Handler,
+		},
+		{
+			MethodName: "CodeMetadata",
+			Handler:    _Query_CodeMetadata_Handler,
+		},
+		{
+			MethodName: "ContractCreation",
+			Handler:    _Query_ContractCreation_Handler,
+		},
+		{
+			MethodName: "Preinstalls",
+			Handler:    _Query_Preinstalls_Handler,
+		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "AccountRange",
+			Handler:    _Query_AccountRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
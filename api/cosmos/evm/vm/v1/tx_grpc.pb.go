@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
-	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_EthereumTx_FullMethodName           = "/cosmos.evm.vm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_RegisterPreinstalls_FullMethodName  = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_RegisterContractABIs_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterContractABIs"
+	Msg_RemoveContractABIs_FullMethodName   = "/cosmos.evm.vm.v1.Msg/RemoveContractABIs"
+	Msg_ScheduleEVMFork_FullMethodName      = "/cosmos.evm.vm.v1.Msg/ScheduleEVMFork"
+	Msg_RegisterCodeMetadata_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterCodeMetadata"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +42,19 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// RegisterContractABIs defines a governance operation for registering
+	// contract ABIs whose logs are additionally emitted as typed Cosmos events.
+	// Registering an already registered address replaces its entry.
+	RegisterContractABIs(ctx context.Context, in *MsgRegisterContractABIs, opts ...grpc.CallOption) (*MsgRegisterContractABIsResponse, error)
+	// RemoveContractABIs defines a governance operation for removing contract
+	// ABIs from the event registry.
+	RemoveContractABIs(ctx context.Context, in *MsgRemoveContractABIs, opts ...grpc.CallOption) (*MsgRemoveContractABIsResponse, error)
+	// ScheduleEVMFork defines a governance operation for scheduling the
+	// activation of an Ethereum hard fork in the EVM chain config.
+	ScheduleEVMFork(ctx context.Context, in *MsgScheduleEVMFork, opts ...grpc.CallOption) (*MsgScheduleEVMForkResponse, error)
+	// RegisterCodeMetadata defines a method for registering the verified
+	// deployment metadata of a contract code.
+	RegisterCodeMetadata(ctx context.Context, in *MsgRegisterCodeMetadata, opts ...grpc.CallOption) (*MsgRegisterCodeMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +92,42 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) RegisterContractABIs(ctx context.Context, in *MsgRegisterContractABIs, opts ...grpc.CallOption) (*MsgRegisterContractABIsResponse, error) {
+	out := new(MsgRegisterContractABIsResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterContractABIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveContractABIs(ctx context.Context, in *MsgRemoveContractABIs, opts ...grpc.CallOption) (*MsgRemoveContractABIsResponse, error) {
+	out := new(MsgRemoveContractABIsResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveContractABIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleEVMFork(ctx context.Context, in *MsgScheduleEVMFork, opts ...grpc.CallOption) (*MsgScheduleEVMForkResponse, error) {
+	out := new(MsgScheduleEVMForkResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleEVMFork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCodeMetadata(ctx context.Context, in *MsgRegisterCodeMetadata, opts ...grpc.CallOption) (*MsgRegisterCodeMetadataResponse, error) {
+	out := new(MsgRegisterCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterCodeMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -89,6 +142,19 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// RegisterContractABIs defines a governance operation for registering
+	// contract ABIs whose logs are additionally emitted as typed Cosmos events.
+	// Registering an already registered address replaces its entry.
+	RegisterContractABIs(context.Context, *MsgRegisterContractABIs) (*MsgRegisterContractABIsResponse, error)
+	// RemoveContractABIs defines a governance operation for removing contract
+	// ABIs from the event registry.
+	RemoveContractABIs(context.Context, *MsgRemoveContractABIs) (*MsgRemoveContractABIsResponse, error)
+	// ScheduleEVMFork defines a governance operation for scheduling the
+	// activation of an Ethereum hard fork in the EVM chain config.
+	ScheduleEVMFork(context.Context, *MsgScheduleEVMFork) (*MsgScheduleEVMForkResponse, error)
+	// RegisterCodeMetadata defines a method for registering the verified
+	// deployment metadata of a contract code.
+	RegisterCodeMetadata(context.Context, *MsgRegisterCodeMetadata) (*MsgRegisterCodeMetadataResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +171,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (UnimplementedMsgServer) RegisterContractABIs(context.Context, *MsgRegisterContractABIs) (*MsgRegisterContractABIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContractABIs not implemented")
+}
+func (UnimplementedMsgServer) RemoveContractABIs(context.Context, *MsgRemoveContractABIs) (*MsgRemoveContractABIsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContractABIs not implemented")
+}
+func (UnimplementedMsgServer) ScheduleEVMFork(context.Context, *MsgScheduleEVMFork) (*MsgScheduleEVMForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleEVMFork not implemented")
+}
+func (UnimplementedMsgServer) RegisterCodeMetadata(context.Context, *MsgRegisterCodeMetadata) (*MsgRegisterCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCodeMetadata not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +250,78 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterContractABIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterContractABIs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterContractABIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterContractABIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterContractABIs(ctx, req.(*MsgRegisterContractABIs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveContractABIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveContractABIs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveContractABIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveContractABIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveContractABIs(ctx, req.(*MsgRemoveContractABIs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleEVMFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleEVMFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleEVMFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleEVMFork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleEVMFork(ctx, req.(*MsgScheduleEVMFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCodeMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterCodeMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCodeMetadata(ctx, req.(*MsgRegisterCodeMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +341,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "RegisterContractABIs",
+			Handler:    _Msg_RegisterContractABIs_Handler,
+		},
+		{
+			MethodName: "RemoveContractABIs",
+			Handler:    _Msg_RemoveContractABIs_Handler,
+		},
+		{
+			MethodName: "ScheduleEVMFork",
+			Handler:    _Msg_ScheduleEVMFork_Handler,
+		},
+		{
+			MethodName: "RegisterCodeMetadata",
+			Handler:    _Msg_RegisterCodeMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
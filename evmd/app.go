@@ -12,7 +12,7 @@ import (
 	"github.com/spf13/cast"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
-	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
@@ -45,6 +45,7 @@ import (
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	_ "github.com/cosmos/evm/x/vm/tracers" // register the Parity vmTrace tracer
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
@@ -787,14 +788,14 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	app.SetAnteHandler(evmante.NewAnteHandler(options))
 }
 
-func (app *EVMD) onPendingTx(hash common.Hash) {
+func (app *EVMD) onPendingTx(tx *ethtypes.Transaction) {
 	for _, listener := range app.pendingTxListeners {
-		listener(hash)
+		listener(tx)
 	}
 }
 
 // RegisterPendingTxListener is used by json-rpc server to listen to pending transactions callback.
-func (app *EVMD) RegisterPendingTxListener(listener func(common.Hash)) {
+func (app *EVMD) RegisterPendingTxListener(listener func(*ethtypes.Transaction)) {
 	app.pendingTxListeners = append(app.pendingTxListeners, listener)
 }
 
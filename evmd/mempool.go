@@ -52,7 +52,7 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 			sdkmempool.NewDefaultSignerExtractionAdapter(),
 		),
 	)
-	app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
+	app.SetPrepareProposal(evmmempool.NewBundleProposalHandler(evmMempool, abciProposalHandler.PrepareProposalHandler()))
 
 	return nil
 }
@@ -9,3 +9,7 @@ import (
 func TestKVIndexer(t *testing.T) {
 	indexer.TestKVIndexer(t, CreateEvmd)
 }
+
+func TestKVIndexerLogIndex(t *testing.T) {
+	indexer.TestKVIndexerLogIndex(t, CreateEvmd)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -15,6 +16,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,11 +25,19 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash      = 1
+	KeyPrefixTxIndex     = 2
+	KeyPrefixAddressTx   = 3
+	KeyPrefixSenderNonce = 4
+	KeyPrefixLogBlock    = 5
+	KeyPrefixLogAddress  = 6
+	KeyPrefixLogTopic    = 7
+	KeyLogIndexRange     = 8
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
 )
 
 var _ servertypes.EVMTxIndexer = &KVIndexer{}
@@ -115,8 +125,14 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if err := saveAddressIndices(batch, ethMsg, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 	}
+	if err := kv.indexLogs(batch, height, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -161,6 +177,68 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*serv
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetByAddress returns the hashes of up to limit eth txs sent or received by
+// the given address, in blocks strictly before (reverse) or after the given
+// block number. A zero block number starts from the latest (reverse) or the
+// first indexed block. The txs of the last returned block are always all
+// returned, so the result may exceed the limit. The returned bool reports
+// whether more txs remain.
+func (kv *KVIndexer) GetByAddress(address common.Address, blockNumber int64, reverse bool, limit int) ([]common.Hash, bool, error) {
+	prefix := append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+	start, end := prefix, storetypes.PrefixEndBytes(prefix)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		if blockNumber > 0 {
+			end = AddressTxKey(address, blockNumber, 0)
+		}
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		if blockNumber > 0 {
+			start = AddressTxKey(address, blockNumber+1, 0)
+		}
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var (
+		hashes     []common.Hash
+		lastHeight int64
+	)
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromAddressTxKey(it.Key())
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+		}
+		if len(hashes) >= limit && height != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = height
+	}
+	return hashes, false, nil
+}
+
+// GetBySenderAndNonce returns the hash of the eth tx sent by the given address
+// with the given nonce, or nil if not found.
+func (kv *KVIndexer) GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := kv.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +251,18 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), bz1...), bz2...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	return append(append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -224,6 +314,31 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddressIndices indexes the eth tx by its sender and nonce, and by the
+// addresses of its sender and recipient, or created contract, into the kv db
+// batch. The addresses of the internal calls are not indexed, since they are
+// only known by tracing the tx.
+func saveAddressIndices(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) error {
+	tx := msg.AsTransaction()
+	txHash := tx.Hash()
+	sender := msg.GetSender()
+
+	if err := batch.Set(SenderNonceKey(sender, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender-nonce key")
+	}
+
+	recipient := crypto.CreateAddress(sender, tx.Nonce())
+	if tx.To() != nil {
+		recipient = *tx.To()
+	}
+	for _, address := range []common.Address{sender, recipient} {
+		if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -231,3 +346,11 @@ func parseBlockNumberFromKey(key []byte) (int64, error) {
 
 	return int64(sdk.BigEndianToUint64(key[1:9])), nil //#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
 }
+
+func parseBlockNumberFromAddressTxKey(key []byte) (int64, error) {
+	if len(key) != AddressTxKeyLength {
+		return 0, fmt.Errorf("wrong address tx key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])), nil //#nosec G115 -- int overflow is not a concern here
+}
@@ -0,0 +1,204 @@
+package indexer
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxLogTopics is the maximum number of topics of a log (LOG4).
+const maxLogTopics = 4
+
+// indexLogs indexes the blocks by the addresses and topics of their logs into
+// the kv db batch, and extends the range of blocks covered by the log index.
+func (kv *KVIndexer) indexLogs(batch dbm.Batch, height int64, txResults []*abci.ExecTxResult) error {
+	var hasLogs bool
+	for txIndex, result := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //#nosec G115 -- block height is not negative
+		if err != nil {
+			kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		for _, log := range logs {
+			hasLogs = true
+			if err := batch.Set(LogAddressKey(log.Address, height), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-address key")
+			}
+			for position, topic := range log.Topics {
+				if position >= maxLogTopics {
+					break
+				}
+				if err := batch.Set(LogTopicKey(position, topic, height), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log-topic key")
+				}
+			}
+		}
+	}
+	if hasLogs {
+		if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-block key")
+		}
+	}
+
+	first, last, err := LoadLogIndexRange(kv.db)
+	if err != nil {
+		return err
+	}
+	switch {
+	case first == -1:
+		first, last = height, height
+	case height == last+1:
+		last = height
+	case height == first-1:
+		first = height
+	case height < first || height > last:
+		// blocks are not indexed contiguously, restart the covered range
+		first, last = height, height
+	}
+	if err := batch.Set([]byte{KeyLogIndexRange}, append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)); err != nil { //nolint:gosec // G115 // block numbers are not negative
+		return errorsmod.Wrap(err, "set log-index-range key")
+	}
+	return nil
+}
+
+// BlocksWithLogs returns the numbers of the blocks within [from, to] which may
+// contain logs matching the given addresses and topics, in ascending order.
+// The returned bool is false if the range is not covered by the log index, in
+// which case the blocks have to be scanned.
+func (kv *KVIndexer) BlocksWithLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	first, last, err := LoadLogIndexRange(kv.db)
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from < first || to > last || from > to {
+		return nil, false, nil
+	}
+	if len(topics) > maxLogTopics {
+		return []int64{}, true, nil
+	}
+
+	var criteria [][][]byte
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = logAddressPrefix(address)
+		}
+		criteria = append(criteria, prefixes)
+	}
+	for position, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		prefixes := make([][]byte, len(topicList))
+		for i, topic := range topicList {
+			prefixes[i] = logTopicPrefix(position, topic)
+		}
+		criteria = append(criteria, prefixes)
+	}
+	if len(criteria) == 0 {
+		criteria = [][][]byte{{{KeyPrefixLogBlock}}}
+	}
+
+	// intersect the blocks matching any of the values of every criterion
+	var candidates map[int64]struct{}
+	for _, prefixes := range criteria {
+		matches := make(map[int64]struct{})
+		for _, prefix := range prefixes {
+			if err := kv.collectLogBlocks(prefix, from, to, candidates, matches); err != nil {
+				return nil, false, err
+			}
+		}
+		candidates = matches
+		if len(candidates) == 0 {
+			break
+		}
+	}
+
+	heights := make([]int64, 0, len(candidates))
+	for height := range candidates {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, true, nil
+}
+
+// collectLogBlocks adds the blocks within [from, to] indexed under the given
+// prefix to matches, restricted to the candidates if not nil.
+func (kv *KVIndexer) collectLogBlocks(prefix []byte, from, to int64, candidates, matches map[int64]struct{}) error {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115 // block numbers are not negative
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115 // block numbers are not negative
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return errorsmod.Wrap(err, "BlocksWithLogs")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+8 {
+			return fmt.Errorf("wrong log index key length, expect: %d, got: %d", len(prefix)+8, len(key))
+		}
+		height := int64(sdk.BigEndianToUint64(key[len(prefix):])) //#nosec G115 -- int overflow is not a concern here
+		if candidates != nil {
+			if _, ok := candidates[height]; !ok {
+				continue
+			}
+		}
+		matches[height] = struct{}{}
+	}
+	return nil
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil` of the blocks
+// with logs
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number) -> nil`
+// of the blocks with logs emitted by the address
+func LogAddressKey(address common.Address, blockNumber int64) []byte {
+	return append(logAddressPrefix(address), sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// LogTopicKey returns the key for db entry: `(position, topic, block number) -> nil`
+// of the blocks with logs having the topic at the given position
+func LogTopicKey(position int, topic common.Hash, blockNumber int64) []byte {
+	return append(logTopicPrefix(position, topic), sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+func logAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...) //nolint:gosec // G115 // position is lower than maxLogTopics
+}
+
+// LoadLogIndexRange returns the first and last block numbers covered by the
+// log index, returns -1 if the log index is empty
+func LoadLogIndexRange(db dbm.DB) (int64, int64, error) {
+	bz, err := db.Get([]byte{KeyLogIndexRange})
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LoadLogIndexRange")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log index range length, expect: 16, got: %d", len(bz))
+	}
+	first := int64(sdk.BigEndianToUint64(bz[:8])) //#nosec G115 -- int overflow is not a concern here
+	last := int64(sdk.BigEndianToUint64(bz[8:]))  //#nosec G115 -- int overflow is not a concern here
+	return first, last, nil
+}
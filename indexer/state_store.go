@@ -0,0 +1,643 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	KeyPrefixStateStorage           = 1
+	KeyPrefixStateCodeHash          = 2
+	KeyPrefixStateCode              = 3
+	KeyPrefixStateBalance           = 4
+	KeyPrefixStateFractionalBalance = 5
+	KeyStateRange                   = 6
+	KeyPrefixStateModified          = 7
+	KeyPrefixStateAccount           = 8
+	KeyPrefixStateBlockTime         = 9
+
+	// snapshotBatchSize is the number of entries written per batch when
+	// snapshotting the state, bounding the memory used by the snapshot.
+	snapshotBatchSize = 10_000
+)
+
+// ErrStateNotAvailable is returned when reading the historical state of a
+// block out of the range covered by the state store.
+var ErrStateNotAvailable = errors.New("historical state not available for this block")
+
+var (
+	_ storetypes.ABCIListener           = &StateStore{}
+	_ servertypes.HistoricalStateReader = &StateStore{}
+)
+
+// stateSource is a range of store entries recorded by the state store.
+type stateSource struct {
+	store  string
+	prefix []byte
+	kind   byte
+}
+
+// stateSources returns the store entries backing the EVM accounts: the
+// contract storage, code hashes and codes of x/vm, the bank balances, the
+// precisebank fractional balances and the vesting accounts, whose locked
+// coins aren't spendable.
+func stateSources() []stateSource {
+	return []stateSource{
+		{evmtypes.StoreKey, evmtypes.KeyPrefixStorage, KeyPrefixStateStorage},
+		{evmtypes.StoreKey, evmtypes.KeyPrefixCodeHash, KeyPrefixStateCodeHash},
+		{evmtypes.StoreKey, evmtypes.KeyPrefixCode, KeyPrefixStateCode},
+		{banktypes.StoreKey, banktypes.BalancesPrefix.Bytes(), KeyPrefixStateBalance},
+		{precisebanktypes.StoreKey, precisebanktypes.FractionalBalancePrefix, KeyPrefixStateFractionalBalance},
+		{authtypes.StoreKey, authtypes.AddressStoreKeyPrefix, KeyPrefixStateAccount},
+	}
+}
+
+// StateStore is the historical state store of the EVM accounts. It listens
+// to the commits of the app and records the writes of each block to the
+// entries backing the EVM accounts, keyed by entry and height, so that the
+// state of any block since the store was enabled can be read without the
+// IAVL versions of the app, which may be pruned.
+//
+// The first recorded block, and the first block following a gap in the
+// recorded blocks, is covered by a snapshot of the entries taken from the
+// committed app state in the background, the blocks being only served once
+// the snapshot completes. The snapshots run one at a time and read a version
+// of the app, which is only kept for the keep-recent blocks of its pruning: a
+// snapshot outlasting the version fails and is retried on a newer one.
+type StateStore struct {
+	db      dbm.DB
+	logger  log.Logger
+	cms     storetypes.CommitMultiStore
+	cdc     codec.Codec
+	keys    map[string]storetypes.StoreKey
+	sources []stateSource
+
+	// snapshotMtx serializes the snapshots
+	snapshotMtx sync.Mutex
+
+	mtx sync.RWMutex
+	// base is the first block served, zero until a snapshot completes
+	base int64
+	// latest is the last recorded block
+	latest int64
+	// snapshot is the height of the running snapshot, zero if none
+	snapshot int64
+}
+
+// NewStateStore creates the StateStore recording the state of the given app
+// multistore, and enables the listening of the recorded stores. The store
+// must be added to the ABCI listeners of the app.
+func NewStateStore(db dbm.DB, logger log.Logger, cms storetypes.CommitMultiStore) (*StateStore, error) {
+	named, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil, fmt.Errorf("unsupported multistore %T", cms)
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+
+	s := &StateStore{
+		db:     db,
+		logger: logger,
+		cms:    cms,
+		cdc:    codec.NewProtoCodec(registry),
+		keys:   make(map[string]storetypes.StoreKey),
+	}
+
+	var listened []storetypes.StoreKey
+	for _, source := range stateSources() {
+		key, ok := named.StoreKeysByName()[source.store]
+		if !ok {
+			// e.g. the precisebank module is only used by the chains
+			// without 18 decimals
+			continue
+		}
+		if _, ok := s.keys[source.store]; !ok {
+			listened = append(listened, key)
+		}
+		s.keys[source.store] = key
+		s.sources = append(s.sources, source)
+	}
+	cms.AddListeners(listened)
+
+	bz, err := db.Get([]byte{KeyStateRange})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load state range")
+	}
+	if len(bz) == 16 {
+		s.base = int64(sdk.BigEndianToUint64(bz[:8]))   //nolint:gosec // G115 // heights are positive
+		s.latest = int64(sdk.BigEndianToUint64(bz[8:])) //nolint:gosec // G115 // heights are positive
+	}
+	return s, nil
+}
+
+// Range returns the first and last blocks served by the store, zero if none.
+func (s *StateStore) Range() (int64, int64) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.base == 0 {
+		return 0, 0
+	}
+	return s.base, s.latest
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (s *StateStore) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, recording the writes of
+// the committed block.
+func (s *StateStore) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	// a snapshot of the committed state covers the writes of the block
+	if height != s.latest+1 || (s.base == 0 && s.snapshot == 0) {
+		s.base, s.snapshot = 0, height
+		go s.takeSnapshot(height)
+	} else {
+		for _, pair := range changeSet {
+			if err := s.recordPair(batch, pair, height); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.latest = height
+	if err := batch.Set(BlockTimeKey(height), sdk.Uint64ToBigEndian(uint64(sdkCtx.BlockTime().UnixNano()))); err != nil { //nolint:gosec // G115 // block times are after the epoch
+		return errorsmod.Wrap(err, "set block time")
+	}
+	if err := batch.Set([]byte{KeyStateRange}, s.rangeBytes()); err != nil {
+		return errorsmod.Wrap(err, "set state range")
+	}
+	return batch.WriteSync()
+}
+
+// recordPair records a write to a store entry into the batch.
+func (s *StateStore) recordPair(batch dbm.Batch, pair *storetypes.StoreKVPair, height int64) error {
+	for _, source := range s.sources {
+		if pair.StoreKey != source.store || !bytes.HasPrefix(pair.Key, source.prefix) {
+			continue
+		}
+		key, ok := source.entryKey(pair.Key[len(source.prefix):])
+		if !ok {
+			return nil
+		}
+		// the writes of the entries which aren't recorded, such as the
+		// accounts which aren't vesting, are recorded as deletions
+		value := []byte{0}
+		if recorded, ok := s.entryValue(source, pair.Value); ok && !pair.Delete {
+			value = append([]byte{1}, recorded...)
+		}
+		if err := batch.Set(StateEntryKey(source.kind, key, height), value); err != nil {
+			return errorsmod.Wrap(err, "set state entry")
+		}
+		if addr, ok := source.entryAddress(key); ok {
+			if err := batch.Set(ModifiedAccountKey(height, addr), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set modified account")
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// entryKey returns the key of a store entry in the state store, and false if
+// the entry isn't recorded. Only the bank balances of the EVM denom are
+// recorded, keyed by address.
+func (source stateSource) entryKey(key []byte) ([]byte, bool) {
+	if source.kind != KeyPrefixStateBalance {
+		return key, true
+	}
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return nil, false
+	}
+	addr, denom := key[1:1+int(key[0])], key[1+int(key[0]):]
+	return addr, string(denom) == evmtypes.GetEVMCoinDenom()
+}
+
+// entryValue returns the value of a store entry in the state store, and
+// false if the entry isn't recorded. Only the vesting accounts are recorded.
+func (s *StateStore) entryValue(source stateSource, value []byte) ([]byte, bool) {
+	if source.kind != KeyPrefixStateAccount {
+		return value, true
+	}
+	_, ok := s.vestingAccount(value)
+	return value, ok
+}
+
+// vestingAccount decodes a recorded account, and returns false if it isn't
+// a vesting account.
+func (s *StateStore) vestingAccount(bz []byte) (vestingexported.VestingAccount, bool) {
+	var account sdk.AccountI
+	if len(bz) == 0 || s.cdc.UnmarshalInterface(bz, &account) != nil {
+		return nil, false
+	}
+	vesting, ok := account.(vestingexported.VestingAccount)
+	return vesting, ok
+}
+
+// entryAddress returns the address of the account owning a store entry, and
+// false if the entry isn't owned by an EVM account, such as the codes, keyed
+// by code hash, and the balances of the module accounts. The writes to the
+// accounts don't modify their balance, storage or code.
+func (source stateSource) entryAddress(key []byte) (common.Address, bool) {
+	switch source.kind {
+	case KeyPrefixStateCode, KeyPrefixStateAccount:
+		return common.Address{}, false
+	case KeyPrefixStateStorage:
+		if len(key) < common.AddressLength {
+			return common.Address{}, false
+		}
+		return common.BytesToAddress(key[:common.AddressLength]), true
+	default:
+		return common.BytesToAddress(key), len(key) == common.AddressLength
+	}
+}
+
+// takeSnapshot records all the entries of the committed state at the given
+// height, drops the entries recorded before it, and serves the blocks from
+// this height on once done. A failed snapshot, e.g. whose version is pruned
+// by the app before it completes, is retried on the next commit.
+func (s *StateStore) takeSnapshot(height int64) {
+	s.snapshotMtx.Lock()
+	defer s.snapshotMtx.Unlock()
+
+	// a newer snapshot was started after a gap
+	if !s.isSnapshot(height) {
+		return
+	}
+
+	s.logger.Info("taking a snapshot of the evm state", "height", height)
+	err := s.writeSnapshot(height)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.snapshot != height {
+		return
+	}
+	s.snapshot = 0
+	if err != nil {
+		s.logger.Error("failed to snapshot the evm state", "height", height, "error", err.Error())
+		return
+	}
+	s.base = height
+	if err := s.db.SetSync([]byte{KeyStateRange}, s.rangeBytes()); err != nil {
+		s.logger.Error("failed to set the evm state range", "error", err.Error())
+		return
+	}
+	s.logger.Info("evm state snapshot done", "height", height)
+}
+
+var (
+	// errSnapshotSuperseded is returned when a snapshot is stopped by a
+	// newer one.
+	errSnapshotSuperseded = errors.New("snapshot superseded")
+	// errSnapshotPruned is returned when the version read by a snapshot is
+	// pruned by the app.
+	errSnapshotPruned = errors.New("snapshot version pruned")
+)
+
+// isSnapshot returns whether the snapshot at the given height is the running
+// one.
+func (s *StateStore) isSnapshot(height int64) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.snapshot == height
+}
+
+// isRetained returns whether the app still keeps the version at the given
+// height, the versions being pruned every interval blocks up to the
+// keep-recent ones.
+func (s *StateStore) isRetained(height int64) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	pruning := s.cms.GetPruning()
+	if pruning.GetPruningStrategy() == pruningtypes.PruningNothing || pruning.Interval == 0 {
+		return true
+	}
+	// the last commit pruning the versions
+	interval := int64(pruning.Interval) //nolint:gosec // G115 // the interval is a number of blocks
+	pruned := s.latest - s.latest%interval
+	return pruned <= height || pruned-1-int64(pruning.KeepRecent) < height //nolint:gosec // G115 // keep-recent is a number of blocks
+}
+
+// checkSnapshot returns an error if the snapshot at the given height is
+// stopped by a newer one or if its version is pruned.
+func (s *StateStore) checkSnapshot(height int64) error {
+	if !s.isSnapshot(height) {
+		return errSnapshotSuperseded
+	}
+	if !s.isRetained(height) {
+		return errSnapshotPruned
+	}
+	return nil
+}
+
+func (s *StateStore) writeSnapshot(height int64) error {
+	if err := s.pruneBefore(height); err != nil {
+		return err
+	}
+
+	cms, err := s.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return errorsmod.Wrap(err, "load committed state")
+	}
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+	var size int
+	for _, source := range s.sources {
+		it := storetypes.KVStorePrefixIterator(cms.GetKVStore(s.keys[source.store]), source.prefix)
+		for ; it.Valid(); it.Next() {
+			key, ok := source.entryKey(it.Key()[len(source.prefix):])
+			if !ok {
+				continue
+			}
+			value, ok := s.entryValue(source, it.Value())
+			if !ok {
+				continue
+			}
+			if err := batch.Set(StateEntryKey(source.kind, key, height), append([]byte{1}, value...)); err != nil {
+				it.Close()
+				return errorsmod.Wrap(err, "set state entry")
+			}
+			if size++; size%snapshotBatchSize == 0 {
+				if err := batch.Write(); err != nil {
+					it.Close()
+					return errorsmod.Wrap(err, "write state snapshot")
+				}
+				if err := s.checkSnapshot(height); err != nil {
+					it.Close()
+					return err
+				}
+				batch.Close()
+				batch = s.db.NewBatch()
+			}
+		}
+		it.Close()
+	}
+	// the version may be pruned while read
+	if err := s.checkSnapshot(height); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// pruneBefore drops the entries, modified accounts and block times recorded
+// before the given height, which aren't consistent with the blocks recorded
+// after a gap.
+func (s *StateStore) pruneBefore(height int64) error {
+	before := func(key []byte) bool {
+		return int64(sdk.BigEndianToUint64(key[len(key)-8:])) < height //nolint:gosec // G115 // heights are positive
+	}
+	all := func([]byte) bool { return true }
+	if err := s.prune([]byte{KeyPrefixStateStorage}, []byte{KeyStateRange}, before); err != nil {
+		return err
+	}
+	if err := s.prune([]byte{KeyPrefixStateAccount}, []byte{KeyPrefixStateAccount + 1}, before); err != nil {
+		return err
+	}
+	if err := s.prune(BlockTimeKey(0), BlockTimeKey(height), all); err != nil {
+		return err
+	}
+	return s.prune(ModifiedAccountKey(0, common.Address{}), ModifiedAccountKey(height, common.Address{}), all)
+}
+
+// prune drops the keys of the range selected by the given function.
+func (s *StateStore) prune(start, end []byte, selected func(key []byte) bool) error {
+	it, err := s.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+	var size int
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if !selected(key) {
+			continue
+		}
+		if err := batch.Delete(bytes.Clone(key)); err != nil {
+			return err
+		}
+		if size++; size%snapshotBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+	return batch.Write()
+}
+
+func (s *StateStore) rangeBytes() []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(s.base)), sdk.Uint64ToBigEndian(uint64(s.latest))...) //nolint:gosec // G115 // heights are positive
+}
+
+// get returns the value of the entry at the given height, nil if absent.
+func (s *StateStore) get(kind byte, key []byte, height int64) ([]byte, error) {
+	base, latest := s.Range()
+	if base == 0 || height < base || height > latest {
+		return nil, ErrStateNotAvailable
+	}
+
+	it, err := s.db.ReverseIterator(StateEntryKey(kind, key, 0), StateEntryKey(kind, key, height+1))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() || len(it.Value()) == 0 || it.Value()[0] == 0 {
+		return nil, nil
+	}
+	return bytes.Clone(it.Value()[1:]), nil
+}
+
+// HistoricalBalance returns the spendable balance of the address at the
+// given height, in 18 decimals, like the balance of the live state: the coins
+// of a vesting account locked at the time of the block are excluded.
+func (s *StateStore) HistoricalBalance(address common.Address, height int64) (*big.Int, error) {
+	integer, err := s.getInt(KeyPrefixStateBalance, address.Bytes(), height)
+	if err != nil {
+		return nil, err
+	}
+	fractional, err := s.getInt(KeyPrefixStateFractionalBalance, address.Bytes(), height)
+	if err != nil {
+		return nil, err
+	}
+	accountBz, err := s.get(KeyPrefixStateAccount, address.Bytes(), height)
+	if err != nil {
+		return nil, err
+	}
+	if vesting, ok := s.vestingAccount(accountBz); ok {
+		blockTime, err := s.blockTime(height)
+		if err != nil {
+			return nil, err
+		}
+		locked := vesting.LockedCoins(blockTime).AmountOf(evmtypes.GetEVMCoinDenom())
+		integer = sdkmath.MaxInt(integer.Sub(locked), sdkmath.ZeroInt())
+	}
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+	return integer.Mul(factor).Add(fractional).BigInt(), nil
+}
+
+// HistoricalStorage returns the value of the storage slot of the address at
+// the given height.
+func (s *StateStore) HistoricalStorage(address common.Address, key common.Hash, height int64) (common.Hash, error) {
+	bz, err := s.get(KeyPrefixStateStorage, append(address.Bytes(), key.Bytes()...), height)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// HistoricalCode returns the code of the address at the given height.
+func (s *StateStore) HistoricalCode(address common.Address, height int64) ([]byte, error) {
+	codeHash, err := s.get(KeyPrefixStateCodeHash, address.Bytes(), height)
+	if err != nil || len(codeHash) == 0 {
+		return nil, err
+	}
+	return s.get(KeyPrefixStateCode, codeHash, height)
+}
+
+// ModifiedAccounts returns the addresses of the accounts whose balance,
+// storage or code was written by the blocks after startHeight up to endHeight
+// included, whether by EVM or Cosmos txs, in address order.
+func (s *StateStore) ModifiedAccounts(startHeight, endHeight int64) ([]common.Address, error) {
+	base, latest := s.Range()
+	// the writes of the snapshot block itself aren't recorded
+	if base == 0 || startHeight < base || endHeight > latest {
+		return nil, ErrStateNotAvailable
+	}
+
+	it, err := s.db.Iterator(ModifiedAccountKey(startHeight+1, common.Address{}), ModifiedAccountKey(endHeight+1, common.Address{}))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	modified := make(map[common.Address]struct{})
+	for ; it.Valid(); it.Next() {
+		modified[common.BytesToAddress(it.Key()[9:])] = struct{}{}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	addresses := make([]common.Address, 0, len(modified))
+	for addr := range modified {
+		addresses = append(addresses, addr)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	})
+	return addresses, nil
+}
+
+// blockTime returns the time of the block at the given height.
+func (s *StateStore) blockTime(height int64) (time.Time, error) {
+	bz, err := s.db.Get(BlockTimeKey(height))
+	if err != nil || len(bz) != 8 {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(sdk.BigEndianToUint64(bz))), nil //nolint:gosec // G115 // block times are after the epoch
+}
+
+func (s *StateStore) getInt(kind byte, key []byte, height int64) (sdkmath.Int, error) {
+	bz, err := s.get(kind, key, height)
+	if err != nil || len(bz) == 0 {
+		return sdkmath.ZeroInt(), err
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	return amount, nil
+}
+
+// StateEntryKey returns the key of an entry of the state store at a height:
+// prefix|len(key)|key|height, the length keeping the versions of an entry
+// contiguous.
+func StateEntryKey(kind byte, key []byte, height int64) []byte {
+	bz := make([]byte, 0, 1+2+len(key)+8)
+	bz = append(bz, kind)
+	bz = binary.BigEndian.AppendUint16(bz, uint16(len(key))) //nolint:gosec // G115 // store keys are short
+	bz = append(bz, key...)
+	return append(bz, sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // heights are positive
+}
+
+// ModifiedAccountKey returns the key recording that an account was modified
+// at a height: prefix|height|address, the accounts of a block being
+// contiguous.
+func ModifiedAccountKey(height int64, address common.Address) []byte {
+	bz := make([]byte, 0, 1+8+common.AddressLength)
+	bz = append(bz, KeyPrefixStateModified)
+	bz = append(bz, sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // heights are positive
+	return append(bz, address.Bytes()...)
+}
+
+// BlockTimeKey returns the key of the time of the block at a height:
+// prefix|height.
+func BlockTimeKey(height int64) []byte {
+	return append([]byte{KeyPrefixStateBlockTime}, sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // heights are positive
+}
+
+// StateIndexer is an EVMTxIndexer also serving the historical state recorded
+// by a StateStore.
+type StateIndexer struct {
+	servertypes.EVMTxIndexer
+	*StateStore
+}
+
+// NewStateIndexer returns the indexer serving the historical state of the
+// state store along the indexed txs.
+func NewStateIndexer(indexer servertypes.EVMTxIndexer, state *StateStore) *StateIndexer {
+	return &StateIndexer{EVMTxIndexer: indexer, StateStore: state}
+}
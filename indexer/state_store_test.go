@@ -0,0 +1,359 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testApp is a multistore committing blocks to a StateStore.
+type testApp struct {
+	t     *testing.T
+	store *rootmulti.Store
+	keys  map[string]*storetypes.KVStoreKey
+	state *StateStore
+}
+
+// failingStore fails to load the committed state for the first snapshot.
+type failingStore struct {
+	*rootmulti.Store
+	failed bool
+}
+
+func (s *failingStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	if !s.failed {
+		s.failed = true
+		return nil, errors.New("version not available")
+	}
+	return s.Store.CacheMultiStoreWithVersion(version)
+}
+
+// blockingStore loads the committed state for the first snapshot, and waits
+// for release to be closed before returning it.
+type blockingStore struct {
+	*rootmulti.Store
+	loaded  chan struct{}
+	release chan struct{}
+	blocked bool
+}
+
+func (s *blockingStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	cms, err := s.Store.CacheMultiStoreWithVersion(version)
+	if !s.blocked {
+		s.blocked = true
+		close(s.loaded)
+		<-s.release
+	}
+	return cms, err
+}
+
+func newTestApp(t *testing.T) *testApp {
+	t.Helper()
+	return newTestAppWithStore(t, func(store *rootmulti.Store) storetypes.CommitMultiStore { return store })
+}
+
+// newTestAppWithStore creates the test app, the state store recording the
+// multistore returned by wrap.
+func newTestAppWithStore(t *testing.T, wrap func(*rootmulti.Store) storetypes.CommitMultiStore) *testApp {
+	t.Helper()
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(262144)))
+	require.NoError(t, configurator.WithEVMCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         "uatom",
+		ExtendedDenom: "aatom",
+		DisplayDenom:  "atom",
+		Decimals:      evmtypes.SixDecimals.Uint32(),
+	}).Configure())
+
+	app := &testApp{
+		t:     t,
+		store: rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics()),
+		keys:  map[string]*storetypes.KVStoreKey{},
+	}
+	for _, name := range []string{authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey, precisebanktypes.StoreKey} {
+		app.keys[name] = storetypes.NewKVStoreKey(name)
+		app.store.MountStoreWithDB(app.keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, app.store.LoadLatestVersion())
+
+	state, err := NewStateStore(dbm.NewMemDB(), log.NewNopLogger(), wrap(app.store))
+	require.NoError(t, err)
+	app.state = state
+	return app
+}
+
+func (app *testApp) kvStore(name string) storetypes.KVStore {
+	return app.store.GetKVStore(app.keys[name])
+}
+
+// commit commits the writes of the block, notifying the state store if
+// listen is set. The time of a block is 100s per height.
+func (app *testApp) commit(write func(), listen bool) int64 {
+	write()
+	height := app.store.Commit().Version
+	changeSet := app.store.PopStateCache()
+	if listen {
+		header := cmtproto.Header{Height: height, Time: time.Unix(100*height, 0)}
+		ctx := sdk.NewContext(nil, header, false, log.NewNopLogger())
+		require.NoError(app.t, app.state.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+	}
+	return height
+}
+
+func (app *testApp) waitSnapshot(height int64) {
+	require.Eventually(app.t, func() bool {
+		base, _ := app.state.Range()
+		return base == height
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (app *testApp) setBalance(addr common.Address, denom string, amount int64) {
+	bz, err := sdkmath.NewInt(amount).Marshal()
+	require.NoError(app.t, err)
+	key := append(banktypes.BalancesPrefix.Bytes(), address.MustLengthPrefix(addr.Bytes())...)
+	app.kvStore(banktypes.StoreKey).Set(append(key, denom...), bz)
+}
+
+func (app *testApp) setAccount(account sdk.AccountI) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	bz, err := codec.NewProtoCodec(registry).MarshalInterface(account)
+	require.NoError(app.t, err)
+	app.kvStore(authtypes.StoreKey).Set(append(bytes.Clone(authtypes.AddressStoreKeyPrefix), account.GetAddress()...), bz)
+}
+
+func (app *testApp) setFractionalBalance(addr common.Address, amount int64) {
+	bz, err := sdkmath.NewInt(amount).Marshal()
+	require.NoError(app.t, err)
+	app.kvStore(precisebanktypes.StoreKey).Set(fractionalBalanceKey(addr), bz)
+}
+
+func fractionalBalanceKey(addr common.Address) []byte {
+	return append(bytes.Clone(precisebanktypes.FractionalBalancePrefix), addr.Bytes()...)
+}
+
+func TestStateStore(t *testing.T) {
+	app := newTestApp(t)
+
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	recipient := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	slot := common.HexToHash("0x01")
+	code := []byte{0x60, 0x00}
+	codeHash := common.HexToHash("0xc0de")
+
+	// the first block is covered by a snapshot of the committed state
+	h1 := app.commit(func() {
+		app.setBalance(addr, "uatom", 2)
+		app.setBalance(addr, "stake", 100)
+		app.setFractionalBalance(addr, 5)
+		app.kvStore(evmtypes.StoreKey).Set(evmtypes.StateKey(addr, slot.Bytes()), common.HexToHash("0x2a").Bytes())
+		app.kvStore(evmtypes.StoreKey).Set(append(evmtypes.KeyPrefixCodeHash, addr.Bytes()...), codeHash.Bytes())
+		app.kvStore(evmtypes.StoreKey).Set(append(evmtypes.KeyPrefixCode, codeHash.Bytes()...), code)
+	}, true)
+	_, err := app.state.HistoricalBalance(addr, h1)
+	require.ErrorIs(t, err, ErrStateNotAvailable)
+	app.waitSnapshot(h1)
+
+	// the next blocks are recorded from their writes
+	h2 := app.commit(func() {
+		app.setBalance(addr, "uatom", 3)
+		app.setBalance(addr, "stake", 50)
+		app.setBalance(recipient, "uatom", 1)
+		app.kvStore(precisebanktypes.StoreKey).Delete(fractionalBalanceKey(addr))
+		app.kvStore(evmtypes.StoreKey).Delete(evmtypes.StateKey(addr, slot.Bytes()))
+	}, true)
+
+	base, latest := app.state.Range()
+	require.Equal(t, h1, base)
+	require.Equal(t, h2, latest)
+
+	testCases := []struct {
+		height  int64
+		balance *big.Int
+		value   common.Hash
+	}{
+		{h1, big.NewInt(2_000_000_000_005), common.HexToHash("0x2a")},
+		{h2, big.NewInt(3_000_000_000_000), common.Hash{}},
+	}
+	for _, tc := range testCases {
+		balance, err := app.state.HistoricalBalance(addr, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.balance, balance)
+
+		value, err := app.state.HistoricalStorage(addr, slot, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.value, value)
+
+		got, err := app.state.HistoricalCode(addr, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, code, got)
+	}
+
+	empty, err := app.state.HistoricalCode(common.HexToAddress("0x02"), h2)
+	require.NoError(t, err)
+	require.Empty(t, empty)
+
+	_, err = app.state.HistoricalStorage(addr, slot, h2+1)
+	require.ErrorIs(t, err, ErrStateNotAvailable)
+
+	// the accounts modified by the recorded blocks include the bank transfers
+	modified, err := app.state.ModifiedAccounts(h1, h2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{addr, recipient}, modified)
+	_, err = app.state.ModifiedAccounts(h1-1, h2)
+	require.ErrorIs(t, err, ErrStateNotAvailable)
+
+	// a gap in the recorded blocks restarts from a snapshot, dropping the
+	// blocks recorded before it
+	app.commit(func() { app.setBalance(addr, "uatom", 4) }, false)
+	h4 := app.commit(func() { app.setBalance(addr, "uatom", 5) }, true)
+	app.waitSnapshot(h4)
+
+	balance, err := app.state.HistoricalBalance(addr, h4)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5_000_000_000_000), balance)
+
+	_, err = app.state.HistoricalBalance(addr, h2)
+	require.ErrorIs(t, err, ErrStateNotAvailable)
+	_, err = app.state.ModifiedAccounts(h1, h2)
+	require.ErrorIs(t, err, ErrStateNotAvailable)
+	it, err := app.state.db.Iterator([]byte{KeyPrefixStateModified}, []byte{KeyPrefixStateModified + 1})
+	require.NoError(t, err)
+	require.False(t, it.Valid(), "the modified accounts before the snapshot are pruned")
+	require.NoError(t, it.Close())
+}
+
+func TestStateStoreVestingBalance(t *testing.T) {
+	app := newTestApp(t)
+
+	// 10uatom vesting linearly over the first 1000s, the block times being
+	// 100s per height
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	base := authtypes.NewBaseAccount(addr.Bytes(), nil, 1, 0)
+	vesting, err := vestingtypes.NewContinuousVestingAccount(base, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), 0, 1000)
+	require.NoError(t, err)
+
+	h1 := app.commit(func() {
+		app.setAccount(vesting)
+		app.setBalance(addr, "uatom", 10)
+		app.setFractionalBalance(addr, 5)
+	}, true)
+	app.waitSnapshot(h1)
+	h2 := app.commit(func() {}, true)
+	// the account is no longer a vesting one
+	h3 := app.commit(func() { app.setAccount(base) }, true)
+
+	testCases := []struct {
+		height  int64
+		balance *big.Int
+	}{
+		{h1, big.NewInt(1_000_000_000_005)},
+		{h2, big.NewInt(2_000_000_000_005)},
+		{h3, big.NewInt(10_000_000_000_005)},
+	}
+	for _, tc := range testCases {
+		balance, err := app.state.HistoricalBalance(addr, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.balance, balance, "height %d", tc.height)
+	}
+
+	// the accounts written aren't modified accounts
+	modified, err := app.state.ModifiedAccounts(h1, h3)
+	require.NoError(t, err)
+	require.Empty(t, modified)
+}
+
+func TestStateStoreSnapshot(t *testing.T) {
+	app := newTestAppWithStore(t, func(store *rootmulti.Store) storetypes.CommitMultiStore {
+		return &failingStore{Store: store}
+	})
+	pruning := pruningtypes.NewCustomPruningOptions(10, 10)
+	app.store.SetPruning(pruning)
+
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// the pruning of the app is left as is while snapshotting
+	h1 := app.commit(func() { app.setBalance(addr, "uatom", 1) }, true)
+	require.Equal(t, pruning, app.store.GetPruning())
+
+	// a failed snapshot is retried on the next commit
+	require.Eventually(t, func() bool {
+		return !app.state.isSnapshot(h1)
+	}, 5*time.Second, 10*time.Millisecond)
+	base, _ := app.state.Range()
+	require.Zero(t, base)
+
+	h2 := app.commit(func() { app.setBalance(addr, "uatom", 2) }, true)
+	app.waitSnapshot(h2)
+	balance, err := app.state.HistoricalBalance(addr, h2)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2_000_000_000_000), balance)
+	require.Equal(t, pruning, app.store.GetPruning())
+}
+
+func TestStateStorePrunedSnapshot(t *testing.T) {
+	blocking := &blockingStore{loaded: make(chan struct{}), release: make(chan struct{})}
+	app := newTestAppWithStore(t, func(store *rootmulti.Store) storetypes.CommitMultiStore {
+		blocking.Store = store
+		return blocking
+	})
+	// the versions before the latest two are pruned every two blocks
+	app.store.SetPruning(pruningtypes.NewCustomPruningOptions(1, 2))
+
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	h1 := app.commit(func() { app.setBalance(addr, "uatom", 1) }, true)
+	<-blocking.loaded
+	require.True(t, app.state.isRetained(h1))
+
+	// the version of the snapshot is pruned by the app before it completes
+	app.commit(func() { app.setBalance(addr, "uatom", 2) }, true)
+	require.True(t, app.state.isRetained(h1))
+	h3 := app.commit(func() { app.setBalance(addr, "uatom", 3) }, true)
+	require.True(t, app.state.isRetained(h1))
+	app.commit(func() { app.setBalance(addr, "uatom", 4) }, true)
+	require.False(t, app.state.isRetained(h1))
+	require.True(t, app.state.isRetained(h3))
+	close(blocking.release)
+
+	// the failed snapshot is retried on the next commit
+	require.Eventually(t, func() bool {
+		return !app.state.isSnapshot(h1)
+	}, 5*time.Second, 10*time.Millisecond)
+	base, _ := app.state.Range()
+	require.Zero(t, base)
+
+	h5 := app.commit(func() { app.setBalance(addr, "uatom", 5) }, true)
+	app.waitSnapshot(h5)
+	balance, err := app.state.HistoricalBalance(addr, h5)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5_000_000_000_000), balance)
+}
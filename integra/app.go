@@ -8,7 +8,7 @@ import (
 	"os"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
-	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/spf13/cast"
 
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"     // register js tracer engine
@@ -43,6 +43,7 @@ import (
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	_ "github.com/cosmos/evm/x/vm/tracers" // register the Parity vmTrace tracer
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
@@ -790,14 +791,14 @@ func (app *IntegraApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted uin
 	app.SetAnteHandler(evmante.NewAnteHandler(options))
 }
 
-func (app *IntegraApp) onPendingTx(hash common.Hash) {
+func (app *IntegraApp) onPendingTx(tx *ethtypes.Transaction) {
 	for _, listener := range app.pendingTxListeners {
-		listener(hash)
+		listener(tx)
 	}
 }
 
 // RegisterPendingTxListener is used by json-rpc server to listen to pending transactions callback.
-func (app *IntegraApp) RegisterPendingTxListener(listener func(common.Hash)) {
+func (app *IntegraApp) RegisterPendingTxListener(listener func(*ethtypes.Transaction)) {
 	app.pendingTxListeners = append(app.pendingTxListeners, listener)
 }
 
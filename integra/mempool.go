@@ -52,7 +52,7 @@ func (app *IntegraApp) configureEVMMempool(appOpts servertypes.AppOptions, logge
 			sdkmempool.NewDefaultSignerExtractionAdapter(),
 		),
 	)
-	app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
+	app.SetPrepareProposal(evmmempool.NewBundleProposalHandler(evmMempool, abciProposalHandler.PrepareProposalHandler()))
 
 	return nil
 }
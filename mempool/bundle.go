@@ -0,0 +1,256 @@
+package mempool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxBundles is the maximum number of bundles held by the mempool.
+	maxBundles = 1024
+	// maxSenderBundles is the maximum number of bundles held for a sender.
+	maxSenderBundles = 16
+	// MaxBundleBlocks is the maximum number of blocks after the latest one a
+	// bundle can target. The bundles without max block expire after them.
+	MaxBundleBlocks = 100
+)
+
+var (
+	ErrEmptyBundle    = errors.New("bundle has no transactions")
+	ErrBundlePoolFull = errors.New("bundle pool is full")
+)
+
+// Bundle is a list of EVM transactions held locally by the node, outside of
+// the gossiped txpool. A bundle is only included, all-or-nothing and in order,
+// at the top of the blocks proposed by this node. A private transaction is a
+// bundle of a single transaction.
+type Bundle struct {
+	Txs []*ethtypes.Transaction
+	// MinBlock and MaxBlock bound the heights of the blocks the bundle can be
+	// included in, zero meaning unbounded.
+	MinBlock uint64
+	MaxBlock uint64
+	// MinTimestamp and MaxTimestamp bound the times of the blocks the bundle
+	// can be included in, zero meaning unbounded.
+	MinTimestamp uint64
+	MaxTimestamp uint64
+
+	// sender is the sender of the first transaction, the bundles are
+	// accounted to. It is set on insertion, along with the transactions
+	// wrapped in MsgEthereumTx, encoded, and their proto size and gas limit.
+	sender  common.Address
+	msgs    []*evmtypes.MsgEthereumTx
+	txs     []sdk.Tx
+	txBytes [][]byte
+	size    int64
+	gas     int64
+}
+
+// Hash returns the hash of the bundle, the keccak256 hash of the
+// concatenated hashes of its transactions.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// Validate checks that the bundle has transactions and consistent bounds.
+func (b *Bundle) Validate() error {
+	if len(b.Txs) == 0 {
+		return ErrEmptyBundle
+	}
+	if b.MaxBlock != 0 && b.MinBlock > b.MaxBlock {
+		return fmt.Errorf("bundle min block %d is higher than max block %d", b.MinBlock, b.MaxBlock)
+	}
+	if b.MaxTimestamp != 0 && b.MinTimestamp > b.MaxTimestamp {
+		return fmt.Errorf("bundle min timestamp %d is higher than max timestamp %d", b.MinTimestamp, b.MaxTimestamp)
+	}
+	return nil
+}
+
+// bound checks that the bundle doesn't target blocks more than
+// MaxBundleBlocks after the latest block, and bounds the max block of the
+// bundles without one to it.
+func (b *Bundle) bound(latest uint64) error {
+	limit := latest + MaxBundleBlocks
+	if b.MinBlock > limit || b.MaxBlock > limit {
+		return fmt.Errorf("bundle targets a block more than %d blocks after the latest block %d", MaxBundleBlocks, latest)
+	}
+	if b.MaxBlock == 0 {
+		b.MaxBlock = limit
+	} else if b.MaxBlock <= latest {
+		return fmt.Errorf("bundle max block %d is not after the latest block %d", b.MaxBlock, latest)
+	}
+	return nil
+}
+
+// expired returns true if the bundle can't be included in the block with the
+// given height and time, or in any later one.
+func (b *Bundle) expired(height, timestamp uint64) bool {
+	return (b.MaxBlock != 0 && height > b.MaxBlock) ||
+		(b.MaxTimestamp != 0 && timestamp > b.MaxTimestamp)
+}
+
+// eligible returns true if the bundle can be included in the block with the
+// given height and time.
+func (b *Bundle) eligible(height, timestamp uint64) bool {
+	return !b.expired(height, timestamp) && height >= b.MinBlock && timestamp >= b.MinTimestamp
+}
+
+// contains returns true if the bundle contains the transaction with the given
+// hash.
+func (b *Bundle) contains(hash common.Hash) bool {
+	for _, tx := range b.Txs {
+		if tx.Hash() == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// bundlePool holds the bundles in insertion order, counting the bundles of
+// each sender.
+type bundlePool struct {
+	mtx     sync.Mutex
+	bundles []*Bundle
+	hashes  map[common.Hash]struct{}
+	senders map[common.Address]int
+}
+
+func newBundlePool() *bundlePool {
+	return &bundlePool{
+		hashes:  make(map[common.Hash]struct{}),
+		senders: make(map[common.Address]int),
+	}
+}
+
+// insert adds the bundle to the pool, ignoring already known bundles. The
+// oldest bundle of the sender is evicted if it holds maxSenderBundles
+// bundles. Once the pool is full, the oldest bundle of the sender holding the
+// most bundles is evicted, unless the sender of the bundle holds as many.
+func (p *bundlePool) insert(bundle *Bundle) (common.Hash, error) {
+	hash := bundle.Hash()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.hashes[hash]; ok {
+		return hash, nil
+	}
+	switch {
+	case p.senders[bundle.sender] >= maxSenderBundles:
+		p.evictOldest(bundle.sender)
+	case len(p.bundles) >= maxBundles:
+		sender, count := p.largestSender()
+		if count <= p.senders[bundle.sender] {
+			return common.Hash{}, ErrBundlePoolFull
+		}
+		p.evictOldest(sender)
+	}
+	p.bundles = append(p.bundles, bundle)
+	p.hashes[hash] = struct{}{}
+	p.senders[bundle.sender]++
+	return hash, nil
+}
+
+// largestSender returns the sender holding the most bundles and their
+// number. The caller must hold the lock.
+func (p *bundlePool) largestSender() (common.Address, int) {
+	var (
+		largest common.Address
+		most    int
+	)
+	for sender, count := range p.senders {
+		if count > most || (count == most && bytes.Compare(sender.Bytes(), largest.Bytes()) < 0) {
+			largest, most = sender, count
+		}
+	}
+	return largest, most
+}
+
+// evictOldest drops the oldest bundle of the sender. The caller must hold the
+// lock.
+func (p *bundlePool) evictOldest(sender common.Address) {
+	evicted := false
+	p.filter(func(bundle *Bundle) bool {
+		if evicted || bundle.sender != sender {
+			return true
+		}
+		evicted = true
+		return false
+	})
+}
+
+// selectBundles drops the expired bundles and returns the ones eligible for
+// the block with the given height and time.
+func (p *bundlePool) selectBundles(height, timestamp uint64) []*Bundle {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.filter(func(bundle *Bundle) bool { return !bundle.expired(height, timestamp) })
+
+	var selected []*Bundle
+	for _, bundle := range p.bundles {
+		if bundle.eligible(height, timestamp) {
+			selected = append(selected, bundle)
+		}
+	}
+	return selected
+}
+
+// removeTx drops the bundles containing the transaction with the given hash,
+// as they can't be included once one of their transactions is.
+func (p *bundlePool) removeTx(hash common.Hash) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.filter(func(bundle *Bundle) bool { return !bundle.contains(hash) })
+}
+
+// remove drops the bundle from the pool.
+func (p *bundlePool) remove(bundle *Bundle) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.filter(func(b *Bundle) bool { return b != bundle })
+}
+
+// count returns the number of bundles in the pool.
+func (p *bundlePool) count() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return len(p.bundles)
+}
+
+// filter keeps the bundles for which keep returns true. The caller must hold
+// the lock.
+func (p *bundlePool) filter(keep func(*Bundle) bool) {
+	kept := p.bundles[:0]
+	for _, bundle := range p.bundles {
+		if keep(bundle) {
+			kept = append(kept, bundle)
+			continue
+		}
+		delete(p.hashes, bundle.Hash())
+		if p.senders[bundle.sender]--; p.senders[bundle.sender] == 0 {
+			delete(p.senders, bundle.sender)
+		}
+	}
+	for i := len(kept); i < len(p.bundles); i++ {
+		p.bundles[i] = nil
+	}
+	p.bundles = kept
+}
@@ -0,0 +1,67 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundlePoolLimits(t *testing.T) {
+	pool := newBundlePool()
+
+	var nonce uint64
+	newBundle := func(sender common.Address) *Bundle {
+		nonce++
+		return &Bundle{Txs: []*ethtypes.Transaction{ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce})}, sender: sender}
+	}
+	addr := func(n int) common.Address {
+		return common.BigToAddress(big.NewInt(int64(n)))
+	}
+	insert := func(bundle *Bundle) error {
+		_, err := pool.insert(bundle)
+		return err
+	}
+	held := func(bundle *Bundle) bool {
+		_, ok := pool.hashes[bundle.Hash()]
+		return ok
+	}
+
+	// a sender holding the max number of bundles has its oldest one evicted
+	sender := common.HexToAddress("0x01")
+	bundles := make([]*Bundle, maxSenderBundles+1)
+	for i := range bundles {
+		bundles[i] = newBundle(sender)
+		require.NoError(t, insert(bundles[i]))
+	}
+	require.Equal(t, maxSenderBundles, pool.count())
+	require.False(t, held(bundles[0]))
+	require.True(t, held(bundles[1]))
+	require.True(t, held(bundles[maxSenderBundles]))
+
+	// once the pool is full, the oldest bundle of the sender holding the most
+	// bundles is evicted
+	for i := 0; pool.count() < maxBundles; i++ {
+		require.NoError(t, insert(newBundle(addr(1000+i))))
+	}
+	require.Equal(t, maxSenderBundles, pool.senders[sender])
+	require.NoError(t, insert(newBundle(common.HexToAddress("0x02"))))
+	require.Equal(t, maxBundles, pool.count())
+	require.Equal(t, maxSenderBundles-1, pool.senders[sender])
+	require.False(t, held(bundles[1]))
+
+	// unless the sender of the bundle holds as many
+	full := newBundlePool()
+	for i := 0; i < maxBundles; i++ {
+		_, err := full.insert(newBundle(addr(10_000 + i)))
+		require.NoError(t, err)
+	}
+	_, err := full.insert(newBundle(addr(10_000)))
+	require.ErrorIs(t, err, ErrBundlePoolFull)
+
+	// the dropped bundles are no longer counted for their sender
+	pool.remove(bundles[2])
+	require.Equal(t, maxSenderBundles-2, pool.senders[sender])
+}
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +31,7 @@ type VMKeeperI interface {
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
 	KVStoreKeys() map[string]*storetypes.KVStoreKey
 	SetEvmMempool(evmMempool *ExperimentalEVMMempool)
+	EthereumTx(goCtx context.Context, msg *vmtypes.MsgEthereumTx) (*vmtypes.MsgEthereumTxResponse, error)
 }
 
 type FeeMarketKeeperI interface {
@@ -54,6 +54,7 @@ type (
 		txPool       *txpool.TxPool
 		legacyTxPool *legacypool.LegacyPool
 		cosmosPool   sdkmempool.ExtMempool
+		bundles      *bundlePool
 
 		/** Utils **/
 		logger        log.Logger
@@ -178,6 +179,7 @@ func NewExperimentalEVMMempool(
 		txPool:        txPool,
 		legacyTxPool:  txPool.Subpools[0].(*legacypool.LegacyPool),
 		cosmosPool:    cosmosPool,
+		bundles:       newBundlePool(),
 		logger:        logger,
 		txConfig:      txConfig,
 		blockchain:    blockchain,
@@ -330,6 +332,7 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 		// be dequeued as temporarily invalid, only to be requeued a block later.
 		// The EVM mempool handles removal based on account nonce automatically.
 		hash := msg.Hash()
+		m.bundles.removeTx(hash)
 		if m.shouldRemoveFromEVMPool(tx) {
 			m.logger.Debug("manually removing EVM transaction", "tx_hash", hash)
 			m.legacyTxPool.RemoveTx(hash, false, true)
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	context "context"
 	big "math/big"
 
 	mempool "github.com/cosmos/evm/mempool"
@@ -52,6 +53,36 @@ func (_m *VMKeeper) DeleteState(ctx types.Context, addr common.Address, key comm
 	_m.Called(ctx, addr, key)
 }
 
+// EthereumTx provides a mock function with given fields: goCtx, msg
+func (_m *VMKeeper) EthereumTx(goCtx context.Context, msg *vmtypes.MsgEthereumTx) (*vmtypes.MsgEthereumTxResponse, error) {
+	ret := _m.Called(goCtx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EthereumTx")
+	}
+
+	var r0 *vmtypes.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *vmtypes.MsgEthereumTx) (*vmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(goCtx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *vmtypes.MsgEthereumTx) *vmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(goCtx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vmtypes.MsgEthereumTxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *vmtypes.MsgEthereumTx) error); ok {
+		r1 = rf(goCtx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ForEachStorage provides a mock function with given fields: ctx, addr, cb
 func (_m *VMKeeper) ForEachStorage(ctx types.Context, addr common.Address, cb func(common.Hash, common.Hash) bool) {
 	_m.Called(ctx, addr, cb)
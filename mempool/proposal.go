@@ -0,0 +1,215 @@
+package mempool
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InsertBundle adds a bundle of EVM transactions to the node-local bundle
+// pool and returns its hash. The transactions are neither added to the txpool
+// nor broadcast: they are only included by NewBundleProposalHandler when this
+// node proposes a block. The bundle can't target the blocks more than
+// MaxBundleBlocks after the latest one, and its transactions must pass the
+// ante handler, in order, on the latest state.
+func (m *ExperimentalEVMMempool) InsertBundle(bundle *Bundle) (common.Hash, error) {
+	if err := bundle.Validate(); err != nil {
+		return common.Hash{}, err
+	}
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := bundle.bound(uint64(ctx.BlockHeight())); err != nil { //#nosec G115 -- the height is positive
+		return common.Hash{}, err
+	}
+	if err := m.encodeBundle(ctx, bundle); err != nil {
+		return common.Hash{}, err
+	}
+	// the ante handler runs on a branch of the latest state, discarded
+	cacheCtx, _ := ctx.CacheContext()
+	if err := m.applyBundle(cacheCtx, bundle, false); err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := m.bundles.insert(bundle)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	m.logger.Debug("inserted bundle", "bundle_hash", hash, "txs", len(bundle.Txs))
+	return hash, nil
+}
+
+const (
+	// maxProposalBundles is the maximum number of bundles tried per proposal.
+	maxProposalBundles = 64
+	// maxProposalBundleTime is the maximum time spent trying the bundles of a
+	// proposal.
+	maxProposalBundleTime = 200 * time.Millisecond
+)
+
+// CountBundles returns the number of bundles held by the node.
+func (m *ExperimentalEVMMempool) CountBundles() int {
+	return m.bundles.count()
+}
+
+// NewBundleProposalHandler returns a PrepareProposal handler placing the
+// eligible node-local bundles of the mempool at the top of the proposal, ahead
+// of the transactions selected by next. The transactions of a bundle are run
+// through the ante handler and executed on a branch of the proposal state,
+// which is only written if none of them is rejected or reverts. Otherwise the
+// bundle is dropped from the mempool, so that it is not run again for the
+// next blocks. The bundles that don't fit in the block are left for the next
+// ones.
+//
+// At most maxProposalBundles bundles are tried per proposal, for at most
+// maxProposalBundleTime, and the gas limits of the bundles tried, whether they
+// are included or not, add up to at most the block gas limit. The bundles not
+// tried are left for the next blocks.
+func NewBundleProposalHandler(m *ExperimentalEVMMempool, next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		bundles := m.bundles.selectBundles(uint64(req.Height), uint64(req.Time.Unix())) //#nosec G115 -- height and time are positive
+		if len(bundles) == 0 {
+			return next(ctx, req)
+		}
+
+		var maxBlockGas int64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			maxBlockGas = b.MaxGas
+		}
+		// the gas executed is bounded even if the block gas isn't
+		maxExecutedGas := maxBlockGas
+		if maxExecutedGas <= 0 {
+			maxExecutedGas = int64(m.blockGasLimit) //#nosec G115 -- the block gas limit fits in int64
+		}
+
+		var (
+			selected    [][]byte
+			totalBytes  int64
+			totalGas    int64
+			executedGas int64
+			tried       int
+			start       = time.Now()
+		)
+		for _, bundle := range bundles {
+			if tried >= maxProposalBundles || time.Since(start) >= maxProposalBundleTime {
+				m.logger.Debug("bundle proposal budget exhausted", "tried", tried, "elapsed", time.Since(start))
+				break
+			}
+			if totalBytes+bundle.size > req.MaxTxBytes || (maxBlockGas > 0 && totalGas+bundle.gas > maxBlockGas) {
+				m.logger.Debug("bundle doesn't fit in the proposal", "bundle_hash", bundle.Hash())
+				continue
+			}
+			if executedGas+bundle.gas > maxExecutedGas {
+				m.logger.Debug("bundle exceeds the gas left to execute in the proposal", "bundle_hash", bundle.Hash())
+				continue
+			}
+			tried++
+			executedGas += bundle.gas
+
+			cacheCtx, write := ctx.CacheContext()
+			if err := m.applyBundle(cacheCtx, bundle, true); err != nil {
+				m.logger.Debug("bundle dropped", "bundle_hash", bundle.Hash(), "error", err)
+				m.bundles.remove(bundle)
+				continue
+			}
+			write()
+			selected = append(selected, bundle.txBytes...)
+			totalBytes += bundle.size
+			totalGas += bundle.gas
+		}
+
+		if len(selected) == 0 {
+			return next(ctx, req)
+		}
+
+		// the bundles fill the block, no room is left for other transactions
+		if totalBytes >= req.MaxTxBytes || (maxBlockGas > 0 && totalGas >= maxBlockGas) {
+			return &abci.ResponsePrepareProposal{Txs: selected}, nil
+		}
+
+		// leave the room taken by the bundles out of the limits of next
+		nextReq := *req
+		nextReq.MaxTxBytes -= totalBytes
+		if maxBlockGas > 0 {
+			params := ctx.ConsensusParams()
+			block := *params.Block
+			block.MaxGas -= totalGas
+			params.Block = &block
+			ctx = ctx.WithConsensusParams(params)
+		}
+
+		res, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append(selected, res.Txs...)
+		return res, nil
+	}
+}
+
+// encodeBundle sets the sender of the bundle and its transactions wrapped in
+// MsgEthereumTx and encoded, with their proto size and gas limit.
+func (m *ExperimentalEVMMempool) encodeBundle(ctx sdk.Context, bundle *Bundle) error {
+	signer := ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)
+	denom := m.vmKeeper.GetEvmCoinInfo(ctx).Denom
+
+	bundle.msgs = make([]*evmtypes.MsgEthereumTx, len(bundle.Txs))
+	bundle.txs = make([]sdk.Tx, len(bundle.Txs))
+	bundle.txBytes = make([][]byte, len(bundle.Txs))
+	bundle.size, bundle.gas = 0, 0
+	for i, ethTx := range bundle.Txs {
+		msg := &evmtypes.MsgEthereumTx{}
+		if err := msg.FromSignedEthereumTx(ethTx, signer); err != nil {
+			return fmt.Errorf("bundle transaction %d: %w", i, err)
+		}
+		tx, err := msg.BuildTx(m.txConfig.NewTxBuilder(), denom)
+		if err != nil {
+			return fmt.Errorf("bundle transaction %d: %w", i, err)
+		}
+		bz, err := m.txConfig.TxEncoder()(tx)
+		if err != nil {
+			return fmt.Errorf("bundle transaction %d: %w", i, err)
+		}
+		bundle.msgs[i], bundle.txs[i], bundle.txBytes[i] = msg, tx, bz
+		bundle.size += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+		bundle.gas += int64(ethTx.Gas()) //#nosec G115 -- gas limits are bounded by the block gas limit
+	}
+	bundle.sender = bundle.msgs[0].GetSender()
+	return nil
+}
+
+// applyBundle runs the transactions of the bundle, in order, through the ante
+// handler on the given context and, if execute is set, executes them. It
+// fails if any of them is rejected or reverts.
+func (m *ExperimentalEVMMempool) applyBundle(ctx sdk.Context, bundle *Bundle, execute bool) error {
+	for i, tx := range bundle.txs {
+		txCtx := ctx.WithTxBytes(bundle.txBytes[i])
+		if m.anteHandler != nil {
+			newCtx, err := m.anteHandler(txCtx, tx, false)
+			if err != nil {
+				return fmt.Errorf("bundle transaction %d: %w", i, err)
+			}
+			txCtx = newCtx
+		}
+		if !execute {
+			continue
+		}
+		res, err := m.vmKeeper.EthereumTx(txCtx, bundle.msgs[i])
+		if err != nil {
+			return fmt.Errorf("bundle transaction %d: %w", i, err)
+		}
+		if res.Failed() {
+			return fmt.Errorf("bundle transaction %d reverted: %s", i, res.VmError)
+		}
+	}
+	return nil
+}
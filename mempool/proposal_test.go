@@ -0,0 +1,187 @@
+package mempool_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/config"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/mocks"
+	"github.com/cosmos/evm/x/vm/statedb"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// failingNonce is the nonce of the transactions rejected by the test ante
+	// handler.
+	failingNonce = 99
+	// revertingNonce is the nonce of the transactions reverting.
+	revertingNonce = 98
+)
+
+func TestBundleProposalHandler(t *testing.T) {
+	configurator := vmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, vmtypes.SetChainConfig(vmtypes.DefaultChainConfig(config.EighteenDecimalsChainID)))
+	require.NoError(t, configurator.WithEVMCoinInfo(config.ChainsCoinInfo[config.EighteenDecimalsChainID]).Configure())
+
+	encodingConfig := encoding.MakeConfig(config.EighteenDecimalsChainID)
+	vmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	vmKeeper := mocks.NewVMKeeper(t)
+	feeMarketKeeper := mocks.NewFeeMarketKeeper(t)
+	vmKeeper.On("SetEvmMempool", mock.Anything).Return()
+	vmKeeper.On("GetEvmCoinInfo", mock.Anything).Return(config.ChainsCoinInfo[config.EighteenDecimalsChainID]).Maybe()
+	vmKeeper.On("GetBaseFee", mock.Anything).Return(big.NewInt(0)).Maybe()
+	vmKeeper.On("GetParams", mock.Anything).Return(vmtypes.DefaultParams()).Maybe()
+	vmKeeper.On("GetAccount", mock.Anything, mock.Anything).Return(&statedb.Account{}).Maybe()
+	vmKeeper.On("KVStoreKeys").Return(make(map[string]*storetypes.KVStoreKey)).Maybe()
+	feeMarketKeeper.On("GetBlockGasWanted", mock.Anything).Return(uint64(0)).Maybe()
+	vmKeeper.On("EthereumTx", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, msg *vmtypes.MsgEthereumTx) (*vmtypes.MsgEthereumTxResponse, error) {
+			if msg.AsTransaction().Nonce() == revertingNonce {
+				return &vmtypes.MsgEthereumTxResponse{VmError: "execution reverted"}, nil
+			}
+			return &vmtypes.MsgEthereumTxResponse{}, nil
+		},
+	).Maybe()
+
+	key := storetypes.NewKVStoreKey("bundles")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_bundles")).
+		WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1_000_000}})
+
+	// the ante handler records the hashes of the transactions it accepts in
+	// the store, to check which branches are written
+	anteHandler := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		ethTx := tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).AsTransaction()
+		if ethTx.Nonce() == failingNonce {
+			return ctx, vmtypes.ErrInvalidAmount
+		}
+		ctx.KVStore(key).Set(ethTx.Hash().Bytes(), []byte{1})
+		return ctx, nil
+	}
+
+	// the latest block is 3
+	getCtx := func(int64, bool) (sdk.Context, error) {
+		return ctx.WithBlockHeader(cmtproto.Header{Height: 3, Time: time.Now()}), nil
+	}
+	evmMempool := mempool.NewExperimentalEVMMempool(
+		getCtx, log.NewNopLogger(), vmKeeper, feeMarketKeeper, encodingConfig.TxConfig,
+		&mempool.EVMMempoolConfig{AnteHandler: anteHandler, BlockGasLimit: 1_000_000}, 0,
+	)
+	defer evmMempool.Close()
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(vmtypes.GetEthChainConfig().ChainID)
+	newGasTx := func(nonce, gas uint64) *ethtypes.Transaction {
+		to := common.HexToAddress("0x1")
+		tx, err := ethtypes.SignNewTx(privKey, signer, &ethtypes.LegacyTx{Nonce: nonce, To: &to, Gas: gas, GasPrice: big.NewInt(0)})
+		require.NoError(t, err)
+		return tx
+	}
+	newTx := func(nonce uint64) *ethtypes.Transaction {
+		return newGasTx(nonce, 21000)
+	}
+
+	included := &mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(0), newTx(1)}, MinBlock: 5, MaxBlock: 5}
+	reverting := &mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(2), newTx(revertingNonce)}}
+	future := &mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(3)}, MinBlock: 6}
+	expired := &mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(4)}, MaxBlock: 4}
+	for _, bundle := range []*mempool.Bundle{included, reverting, future, expired} {
+		_, err := evmMempool.InsertBundle(bundle)
+		require.NoError(t, err)
+	}
+	hash, err := evmMempool.InsertBundle(included)
+	require.NoError(t, err)
+	require.Equal(t, included.Hash(), hash)
+	require.Equal(t, 4, evmMempool.CountBundles())
+
+	// the bundles without max block expire after the max number of blocks
+	require.Equal(t, uint64(3+mempool.MaxBundleBlocks), reverting.MaxBlock)
+
+	// the invalid bundles are rejected
+	_, err = evmMempool.InsertBundle(&mempool.Bundle{})
+	require.ErrorIs(t, err, mempool.ErrEmptyBundle)
+	_, err = evmMempool.InsertBundle(&mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(5), newTx(failingNonce)}})
+	require.ErrorIs(t, err, vmtypes.ErrInvalidAmount)
+	_, err = evmMempool.InsertBundle(&mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(6)}, MinBlock: 4 + mempool.MaxBundleBlocks})
+	require.ErrorContains(t, err, "more than 100 blocks after the latest block 3")
+	_, err = evmMempool.InsertBundle(&mempool.Bundle{Txs: []*ethtypes.Transaction{newTx(7)}, MaxBlock: 3})
+	require.ErrorContains(t, err, "is not after the latest block 3")
+	require.Equal(t, 4, evmMempool.CountBundles())
+
+	var (
+		nextMaxTxBytes int64
+		nextMaxGas     int64
+	)
+	next := func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		nextMaxTxBytes = req.MaxTxBytes
+		nextMaxGas = ctx.ConsensusParams().Block.MaxGas
+		return &abci.ResponsePrepareProposal{Txs: [][]byte{[]byte("next")}}, nil
+	}
+
+	handler := mempool.NewBundleProposalHandler(evmMempool, next)
+	res, err := handler(ctx, &abci.RequestPrepareProposal{Height: 5, Time: time.Now(), MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+
+	require.Len(t, res.Txs, 3)
+	require.Equal(t, []byte("next"), res.Txs[2])
+	var size int64
+	for i, bz := range res.Txs[:2] {
+		tx, err := encodingConfig.TxConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+		require.Equal(t, included.Txs[i].Hash(), tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).AsTransaction().Hash())
+		size += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+	}
+	require.Equal(t, 1_000_000-size, nextMaxTxBytes)
+	require.Equal(t, int64(1_000_000-2*21000), nextMaxGas)
+
+	// the state of the included bundle is written, the one of the reverting
+	// bundle is discarded
+	store := ctx.KVStore(key)
+	require.True(t, store.Has(included.Txs[0].Hash().Bytes()))
+	require.True(t, store.Has(included.Txs[1].Hash().Bytes()))
+	require.False(t, store.Has(reverting.Txs[0].Hash().Bytes()))
+
+	// the expired and the reverting bundles are dropped
+	require.Equal(t, 2, evmMempool.CountBundles())
+
+	// the gas of the reverting bundles counts towards the gas executed in the
+	// proposal, the last reverting bundle is not tried and left for the next
+	// blocks with the included one
+	var heavy []*mempool.Bundle
+	for i := uint64(0); i < 3; i++ {
+		bundle := &mempool.Bundle{Txs: []*ethtypes.Transaction{newGasTx(revertingNonce, 400_000+i)}}
+		_, err := evmMempool.InsertBundle(bundle)
+		require.NoError(t, err)
+		heavy = append(heavy, bundle)
+	}
+	res, err = handler(ctx, &abci.RequestPrepareProposal{Height: 6, Time: time.Now(), MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	require.Equal(t, []byte("next"), res.Txs[1])
+	require.Equal(t, int64(1_000_000-21000), nextMaxGas)
+	require.Equal(t, 2, evmMempool.CountBundles())
+	_, err = evmMempool.InsertBundle(heavy[2])
+	require.NoError(t, err)
+	require.Equal(t, 2, evmMempool.CountBundles())
+}
@@ -0,0 +1,364 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// ErrCodeUnauthorized is the JSON-RPC error code returned for requests with
+	// a missing or invalid token.
+	ErrCodeUnauthorized = -32001
+	// ErrCodeForbidden is the JSON-RPC error code returned for requests calling
+	// a method that is not allowed for their token.
+	ErrCodeForbidden = -32002
+	// ErrCodeInvalidRequest is the JSON-RPC error code returned for request
+	// bodies that cannot be parsed.
+	ErrCodeInvalidRequest = -32600
+
+	// maxBodySize is the maximum size of the request body read to check the
+	// called methods.
+	maxBodySize = 5 * 1024 * 1024
+)
+
+var (
+	// ErrUnauthorized is returned for requests with a missing or invalid token.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrInvalidRequest is returned for request bodies that cannot be parsed.
+	ErrInvalidRequest = errors.New("invalid request")
+)
+
+type (
+	permissionsKey struct{}
+	forwardedKey   struct{}
+	bodyKey        struct{}
+)
+
+// ContextWithPermissions returns a copy of the context holding the given
+// request permissions.
+func ContextWithPermissions(ctx context.Context, perms *Permissions) context.Context {
+	return context.WithValue(ctx, permissionsKey{}, perms)
+}
+
+// PermissionsFromContext returns the request permissions held by the context,
+// nil if the request is not authenticated.
+func PermissionsFromContext(ctx context.Context) *Permissions {
+	perms, _ := ctx.Value(permissionsKey{}).(*Permissions)
+	return perms
+}
+
+// Forwarded reports whether the request of the context was forwarded by the
+// WebSocket server, which already authorized and rate limited it.
+func Forwarded(ctx context.Context) bool {
+	forwarded, _ := ctx.Value(forwardedKey{}).(bool)
+	return forwarded
+}
+
+// Permissions defines the namespaces and methods a request can call.
+type Permissions struct {
+	// KeyID is the id of the key the request is authenticated with, empty
+	// for unauthenticated requests.
+	KeyID      string
+	namespaces []string
+	methods    []string
+}
+
+// Allowed reports whether the given method can be called.
+func (p *Permissions) Allowed(method string) bool {
+	if p == nil {
+		return true
+	}
+	if slices.Contains(p.methods, method) || slices.Contains(p.namespaces, "*") {
+		return true
+	}
+	namespace, _, ok := strings.Cut(method, "_")
+	return ok && slices.Contains(p.namespaces, namespace)
+}
+
+type key struct {
+	secret      []byte
+	permissions *Permissions
+}
+
+// Authenticator authenticates the JSON-RPC requests with HS256 JWT bearer
+// tokens, and restricts the methods they can call according to the
+// configured API keys.
+type Authenticator struct {
+	enabled bool
+	keys    []*key
+	public  *Permissions
+	// internal is the key granting access to all the methods, used by the
+	// WebSocket server to forward the requests it already authorized.
+	internal *key
+}
+
+// NewAuthenticator creates an Authenticator from the JSON-RPC configuration.
+// All the requests are allowed if authentication is disabled.
+func NewAuthenticator(cfg config.JSONRPCConfig) (*Authenticator, error) {
+	a := &Authenticator{enabled: cfg.EnableAuth}
+
+	// the internal key identifies the forwarded WebSocket requests even if
+	// authentication is disabled
+	secret := make([]byte, config.MinAuthSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate internal secret: %w", err)
+	}
+	a.internal = &key{secret: secret, permissions: newPermissions(config.InternalAuthKeyID, []string{"*"})}
+	if !a.enabled {
+		return a, nil
+	}
+
+	a.public = newPermissions("", cfg.PublicAPI)
+	for _, keyCfg := range cfg.AuthKeys {
+		if err := keyCfg.Validate(); err != nil {
+			return nil, err
+		}
+		secret, _ := hexutil.Decode(keyCfg.Secret)
+		perms := newPermissions(keyCfg.ID, keyCfg.Namespaces)
+		perms.methods = append(perms.methods, keyCfg.Methods...)
+		a.keys = append(a.keys, &key{secret: secret, permissions: perms})
+	}
+	a.keys = append(a.keys, a.internal)
+
+	return a, nil
+}
+
+// newPermissions splits the given allowlist into namespaces and methods.
+func newPermissions(keyID string, allowed []string) *Permissions {
+	perms := &Permissions{KeyID: keyID}
+	for _, entry := range allowed {
+		if strings.Contains(entry, "_") {
+			perms.methods = append(perms.methods, entry)
+		} else {
+			perms.namespaces = append(perms.namespaces, entry)
+		}
+	}
+	return perms
+}
+
+// Enabled reports whether the requests are authenticated.
+func (a *Authenticator) Enabled() bool {
+	return a != nil && a.enabled
+}
+
+// IsInternal reports whether the given permissions are the ones of the
+// internal key, used by the WebSocket server to forward the requests.
+func (a *Authenticator) IsInternal(perms *Permissions) bool {
+	return a != nil && perms != nil && perms == a.internal.permissions
+}
+
+// Authenticate returns the permissions of the request from its bearer token.
+// Requests without token get the permissions of the public API. A nil
+// Permissions allows all the methods.
+func (a *Authenticator) Authenticate(r *http.Request) (*Permissions, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	header := r.Header.Get("Authorization")
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !a.enabled {
+		// only recognize the forwarded WebSocket requests
+		if ok && verifyToken(token, a.internal.secret, time.Now()) == nil {
+			return a.internal.permissions, nil
+		}
+		return nil, nil
+	}
+
+	if header == "" {
+		return a.public, nil
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a bearer token", ErrUnauthorized)
+	}
+
+	now := time.Now()
+	for _, k := range a.keys {
+		err := verifyToken(token, k.secret, now)
+		if err == nil {
+			return k.permissions, nil
+		}
+		if !errors.Is(err, errInvalidSignature) {
+			return nil, fmt.Errorf("%w: %s", ErrUnauthorized, err.Error())
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnauthorized, errInvalidSignature.Error())
+}
+
+// InternalToken returns a token granting access to all the methods, used by
+// the WebSocket server to forward the requests it already authorized.
+func (a *Authenticator) InternalToken() (string, error) {
+	if a == nil {
+		return "", nil
+	}
+	return NewToken(a.internal.secret, time.Now())
+}
+
+// Handler returns an HTTP handler authenticating the requests and checking
+// the called methods before passing them to the given handler. The request
+// permissions are available from the request context, and the requests
+// forwarded by the WebSocket server are marked as such. The request bodies
+// that cannot be parsed, or exceed the maximum body size, are rejected as
+// their methods cannot be checked.
+func (a *Authenticator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perms, err := a.Authenticate(r)
+		if err != nil {
+			WriteError(w, http.StatusUnauthorized, ErrCodeUnauthorized, err.Error())
+			return
+		}
+		ctx := ContextWithPermissions(r.Context(), perms)
+		if a.IsInternal(perms) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, forwardedKey{}, true)))
+			return
+		}
+		r = r.WithContext(ctx)
+		if perms == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, r := ReadBody(r)
+		if body.Err != nil {
+			WriteError(w, http.StatusBadRequest, ErrCodeInvalidRequest, body.Err.Error())
+			return
+		}
+		if err := CheckMethods(perms, body.Requests); err != nil {
+			WriteError(w, http.StatusForbidden, ErrCodeForbidden, err.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Body is the body of a JSON-RPC request, read and parsed once by the
+// outermost handler and shared with the inner ones through the request
+// context.
+type Body struct {
+	// Raw is the body, read up to one byte over the maximum body size.
+	Raw []byte
+	// Requests are the requests of the body, nil if it cannot be parsed.
+	// They are set along with Err for a body followed by other data.
+	Requests []Request
+	// Err is the error reading or parsing the body, wrapping
+	// ErrInvalidRequest.
+	Err error
+}
+
+// ReadBody returns the body of the request, reading and parsing it unless an
+// outer handler already did, and the request sharing it with the inner
+// handlers through its context. The body of the returned request is still
+// read in full by the JSON-RPC server.
+func ReadBody(r *http.Request) (*Body, *http.Request) {
+	if body, ok := r.Context().Value(bodyKey{}).(*Body); ok {
+		return body, r
+	}
+
+	body := &Body{}
+	raw, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	switch {
+	case err != nil:
+		body.Err = fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+	case len(raw) > maxBodySize:
+		body.Err = fmt.Errorf("%w: body exceeds %d bytes", ErrInvalidRequest, maxBodySize)
+	default:
+		body.Requests, body.Err = ParseRequests(raw)
+	}
+	body.Raw = raw
+
+	// the rest of an oversize body is passed on for the JSON-RPC server to
+	// reject it
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(raw), r.Body), Closer: r.Body}
+	return body, r.WithContext(context.WithValue(r.Context(), bodyKey{}, body))
+}
+
+// readCloser closes the body of a request read again.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// CheckMethods returns an error if the requests of a single or batch JSON-RPC
+// request call a method that is not allowed by the given permissions.
+func CheckMethods(perms *Permissions, reqs []Request) error {
+	if perms == nil {
+		return nil
+	}
+
+	for _, req := range reqs {
+		if !perms.Allowed(req.Method) {
+			return fmt.Errorf("method %s is not allowed", req.Method)
+		}
+	}
+	return nil
+}
+
+// Request holds the fields of a JSON-RPC request used to authorize, rate
+// limit and monitor it.
+type Request struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	// Size is the size of the request in the body, in bytes.
+	Size int `json:"-"`
+}
+
+// ParseRequests returns the requests of a single or batch JSON-RPC request
+// body. The body must hold exactly one JSON value: as the JSON-RPC server
+// serves the first value of a body followed by other data, the requests of
+// this value are returned with the error.
+func ParseRequests(body []byte) ([]Request, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	var value json.RawMessage
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+	}
+
+	elems := []json.RawMessage{value}
+	if value[0] == '[' {
+		elems = nil
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+		}
+	}
+	reqs := make([]Request, len(elems))
+	for i, elem := range elems {
+		if err := json.Unmarshal(elem, &reqs[i]); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+		}
+		reqs[i].Size = len(elem)
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return reqs, fmt.Errorf("%w: unexpected data after the request", ErrInvalidRequest)
+	}
+	return reqs, nil
+}
+
+// WriteError writes a JSON-RPC error response with the given status code.
+func WriteError(w http.ResponseWriter, status, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": msg,
+		},
+	})
+}
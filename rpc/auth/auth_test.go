@@ -0,0 +1,212 @@
+package auth_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestAuthenticatorHandler(t *testing.T) {
+	secret := common.Hash{1}.Bytes()
+	otherSecret := common.Hash{2}.Bytes()
+
+	authenticator, err := auth.NewAuthenticator(config.JSONRPCConfig{
+		EnableAuth: true,
+		PublicAPI:  []string{"eth", "net_version"},
+		AuthKeys: []config.AuthKeyConfig{{
+			ID:         "internal-service",
+			Secret:     common.Bytes2Hex(secret),
+			Namespaces: []string{"debug"},
+			Methods:    []string{"txpool_content"},
+		}},
+	})
+	require.Error(t, err, "secret must be 0x prefixed")
+
+	authenticator, err = auth.NewAuthenticator(config.JSONRPCConfig{
+		EnableAuth: true,
+		PublicAPI:  []string{"eth", "net_version"},
+		AuthKeys: []config.AuthKeyConfig{{
+			ID:         "internal-service",
+			Secret:     "0x" + common.Bytes2Hex(secret),
+			Namespaces: []string{"debug"},
+			Methods:    []string{"txpool_content"},
+		}},
+	})
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(authenticator.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// echo the body to check it is passed through
+		_, _ = io.Copy(w, r.Body)
+	})))
+	defer srv.Close()
+
+	token := func(secret []byte, iat time.Time) string {
+		tok, err := auth.NewToken(secret, iat)
+		require.NoError(t, err)
+		return tok
+	}
+	internalToken, err := authenticator.InternalToken()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		token     string
+		body      string
+		expStatus int
+	}{
+		{"public method", "", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`, http.StatusOK},
+		{"public single method", "", `{"jsonrpc":"2.0","id":1,"method":"net_version"}`, http.StatusOK},
+		{"private method without token", "", `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`, http.StatusForbidden},
+		{"private method in batch", "", `[{"method":"eth_chainId"},{"method":"net_peerCount"}]`, http.StatusForbidden},
+		{"allowed namespace", token(secret, time.Now()), `{"method":"debug_traceTransaction"}`, http.StatusOK},
+		{"allowed method", token(secret, time.Now()), `[{"method":"txpool_content"},{"method":"debug_traceCall"}]`, http.StatusOK},
+		{"key does not inherit public API", token(secret, time.Now()), `{"method":"eth_blockNumber"}`, http.StatusForbidden},
+		{"not allowed method", token(secret, time.Now()), `{"method":"personal_sign"}`, http.StatusForbidden},
+		{"unknown secret", token(otherSecret, time.Now()), `{"method":"eth_blockNumber"}`, http.StatusUnauthorized},
+		{"stale token", token(secret, time.Now().Add(-2*time.Minute)), `{"method":"debug_traceCall"}`, http.StatusUnauthorized},
+		{"malformed token", "abc", `{"method":"eth_blockNumber"}`, http.StatusUnauthorized},
+		{"internal token", internalToken, `{"method":"personal_sign"}`, http.StatusOK},
+		{"trailing whitespace", "", "{\"method\":\"eth_blockNumber\"}\n", http.StatusOK},
+		{"trailing data", "", `{"jsonrpc":"2.0","id":1,"method":"debug_secret","params":[]}x`, http.StatusBadRequest},
+		{"concatenated requests", "", `{"method":"eth_blockNumber"}{"method":"debug_traceTransaction"}`, http.StatusBadRequest},
+		{"concatenated batches", "", `[{"method":"eth_blockNumber"}][{"method":"debug_traceTransaction"}]`, http.StatusBadRequest},
+		{"malformed request", "", `{"method":`, http.StatusBadRequest},
+		{"malformed batch", "", `[{"method":"eth_blockNumber"},1]`, http.StatusBadRequest},
+		{"oversize request", "", `{"method":"debug_traceTransaction","params":["` + strings.Repeat("0", 5*1024*1024) + `"]}`, http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, tc.expStatus, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			if tc.expStatus == http.StatusOK {
+				require.Equal(t, tc.body, string(body))
+				return
+			}
+
+			var res struct {
+				Error struct {
+					Code int `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(body, &res))
+			if tc.expStatus == http.StatusBadRequest {
+				require.Equal(t, auth.ErrCodeInvalidRequest, res.Error.Code)
+			}
+			require.NotZero(t, res.Error.Code)
+		})
+	}
+}
+
+func TestAuthenticatorDisabled(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.JSONRPCConfig{})
+	require.NoError(t, err)
+	require.False(t, authenticator.Enabled())
+
+	perms, err := authenticator.Authenticate(httptest.NewRequest(http.MethodPost, "/", nil))
+	require.NoError(t, err)
+	require.Nil(t, perms)
+	require.True(t, perms.Allowed("personal_sign"))
+
+	// the forwarded WebSocket requests are still recognized
+	token, err := authenticator.InternalToken()
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	perms, err = authenticator.Authenticate(req)
+	require.NoError(t, err)
+	require.True(t, authenticator.IsInternal(perms))
+}
+
+func TestInternalKeyID(t *testing.T) {
+	// the id of the internal key is reserved
+	_, err := auth.NewAuthenticator(config.JSONRPCConfig{
+		EnableAuth: true,
+		AuthKeys: []config.AuthKeyConfig{{
+			ID:         config.InternalAuthKeyID,
+			Secret:     "0x" + common.Bytes2Hex(common.Hash{1}.Bytes()),
+			Namespaces: []string{"eth"},
+		}},
+	})
+	require.ErrorContains(t, err, "is reserved")
+
+	// the forwarded requests are identified by the internal key itself
+	authenticator, err := auth.NewAuthenticator(config.JSONRPCConfig{EnableAuth: true, PublicAPI: []string{"eth"}})
+	require.NoError(t, err)
+	internal, err := authenticator.InternalToken()
+	require.NoError(t, err)
+
+	var forwarded bool
+	handler := authenticator.Handler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		forwarded = auth.Forwarded(r.Context())
+	}))
+	for _, token := range []string{"", internal} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":"eth_chainId"}`))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		require.Equal(t, token != "", forwarded)
+	}
+	require.False(t, authenticator.IsInternal(&auth.Permissions{KeyID: config.InternalAuthKeyID}))
+}
+
+func TestReadBody(t *testing.T) {
+	chainID := auth.Request{ID: json.RawMessage("1"), Method: "eth_chainId", Size: 31}
+	blockNumber := auth.Request{ID: json.RawMessage("2"), Method: "eth_blockNumber", Params: json.RawMessage("[]"), Size: 47}
+
+	testCases := []struct {
+		name    string
+		body    string
+		expReqs []auth.Request
+		expErr  string
+	}{
+		{"single request", `{"id":1,"method":"eth_chainId"}`, []auth.Request{chainID}, ""},
+		{"batch request", `[{"id":1,"method":"eth_chainId"}, {"id":2,"method":"eth_blockNumber","params":[]}]`, []auth.Request{chainID, blockNumber}, ""},
+		// the requests served by the JSON-RPC server are returned with the
+		// error
+		{"trailing data", `{"id":1,"method":"eth_chainId"}x`, []auth.Request{chainID}, "unexpected data"},
+		{"oversize body", `{"id":1,"method":"eth_chainId","params":["` + strings.Repeat("0", 5*1024*1024) + `"]}`, nil, "body exceeds"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, r := auth.ReadBody(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body)))
+			if tc.expErr != "" {
+				require.ErrorIs(t, body.Err, auth.ErrInvalidRequest)
+				require.ErrorContains(t, body.Err, tc.expErr)
+			} else {
+				require.NoError(t, body.Err)
+			}
+			require.Equal(t, tc.expReqs, body.Requests)
+
+			// the body is read once, and still passed on in full
+			shared, r := auth.ReadBody(r)
+			require.Same(t, body, shared)
+			raw, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.body, string(raw))
+		})
+	}
+}
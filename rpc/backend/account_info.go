@@ -8,18 +8,26 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/rpc/types/proof"
+	servertypes "github.com/cosmos/evm/server/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GetCode returns the contract code at the given address and block number.
@@ -35,14 +43,85 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 
 	res, err := b.QueryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
+		if state, ok := b.historicalState(blockNum); ok {
+			if code, stateErr := state.HistoricalCode(address, blockNum.Int64()); stateErr == nil {
+				return code, nil
+			}
+		}
 		return nil, err
 	}
 
 	return res.Code, nil
 }
 
+// GetCodeMetadata returns the verified deployment metadata of the code of the
+// contract at the given address and block number, with the deployer and the
+// creation transaction of the contract. It returns nil if no metadata is
+// registered for the code.
+func (b *Backend) GetCodeMetadata(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.CodeMetadataResult, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+
+	res, err := b.QueryClient.CodeMetadata(ctx, &evmtypes.QueryCodeMetadataRequest{Address: address.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// the code may be shared by several contracts, the deployer and the
+	// creation transaction are the ones of the requested contract
+	var creation *evmtypes.ContractCreation
+	creationRes, err := b.QueryClient.ContractCreation(ctx, &evmtypes.QueryContractCreationRequest{Address: address.String()})
+	switch {
+	case err == nil:
+		creation = &creationRes.ContractCreation
+	case status.Code(err) != codes.NotFound:
+		return nil, err
+	}
+
+	return rpctypes.NewCodeMetadataResult(res.CodeMetadata, creation), nil
+}
+
+// GetContractCreator returns the creator and the creation transaction hash of
+// the given contract. It returns nil if no creation record exists for the
+// address.
+func (b *Backend) GetContractCreator(address common.Address) (*rpctypes.ContractCreatorResult, error) {
+	req := &evmtypes.QueryContractCreationRequest{
+		Address: address.String(),
+	}
+
+	res, err := b.QueryClient.ContractCreation(b.Ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rpctypes.ContractCreatorResult{
+		Hash:    common.HexToHash(res.ContractCreation.TxHash),
+		Creator: common.HexToAddress(res.ContractCreation.Creator),
+	}, nil
+}
+
 // GetProof returns an account object with proof and any storage proofs
 func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
+	return b.getProof(address, storageKeys, blockNrOrHash, false)
+}
+
+// GetCommitProof returns an account object with proof and any storage proofs,
+// along with the commit level proof chaining the store entries backing the
+// account to the AppHash of the block following the given one.
+func (b *Backend) GetCommitProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
+	return b.getProof(address, storageKeys, blockNrOrHash, true)
+}
+
+func (b *Backend) getProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash, full bool) (*rpctypes.AccountResult, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -75,13 +154,21 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query storage proofs
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	var commitProof *rpctypes.CommitProof
+	if full {
+		commitProof = &rpctypes.CommitProof{Storage: make([]rpctypes.StoreProof, len(storageKeys))}
+	}
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		stateKey := evmtypes.StateKey(address, hexKey.Bytes())
+		valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, stateKey)
 		if err != nil {
 			return nil, err
 		}
+		if full {
+			commitProof.Storage[i] = newStoreProof(evmtypes.StoreKey, stateKey, valueBz, proof)
+		}
 
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   key,
@@ -102,7 +189,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query account proofs
 	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountBz, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +199,13 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, errors.New("invalid balance")
 	}
 
+	if full {
+		commitProof.Account = newStoreProof(authtypes.StoreKey, accountKey, accountBz, proof)
+		if err := b.completeCommitProof(clientCtx, commitProof, address, height); err != nil {
+			return nil, err
+		}
+	}
+
 	return &rpctypes.AccountResult{
 		Address:      address,
 		AccountProof: GetHexProofs(proof),
@@ -120,9 +214,59 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Nonce:        hexutil.Uint64(res.Nonce),
 		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
 		StorageProof: storageProofs,
+		CommitProof:  commitProof,
 	}, nil
 }
 
+// completeCommitProof adds the proofs of the bank balance, of the precisebank
+// fractional balance when the EVM coin has less than 18 decimals and of the
+// code hash of the address at the given height to the commit proof, along
+// with the AppHash committing the state of that height.
+func (b *Backend) completeCommitProof(clientCtx client.Context, commitProof *rpctypes.CommitProof, address common.Address, height int64) error {
+	balanceKey := proof.BalanceKey(address, evmtypes.GetEVMCoinDenom())
+	balanceBz, balanceProof, err := b.QueryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return err
+	}
+	commitProof.Balance = newStoreProof(banktypes.StoreKey, balanceKey, balanceBz, balanceProof)
+
+	if evmtypes.GetEVMCoinDecimals() != evmtypes.EighteenDecimals {
+		fractionalKey := proof.FractionalBalanceKey(address)
+		fractionalBz, fractionalProof, err := b.QueryClient.GetProof(clientCtx, precisebanktypes.StoreKey, fractionalKey)
+		if err != nil {
+			return err
+		}
+		commitProof.FractionalBalance = newStoreProof(precisebanktypes.StoreKey, fractionalKey, fractionalBz, fractionalProof)
+	}
+
+	codeHashKey := proof.CodeHashKey(address)
+	codeHashBz, codeHashProof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, codeHashKey)
+	if err != nil {
+		return err
+	}
+	commitProof.CodeHash = newStoreProof(evmtypes.StoreKey, codeHashKey, codeHashBz, codeHashProof)
+
+	// the state after the block at the given height is committed by the
+	// AppHash of the next block
+	header, err := b.CometHeaderByNumber(rpctypes.BlockNumber(height + 1))
+	if err != nil || header == nil || header.Header == nil {
+		return fmt.Errorf("the app hash committing the state of block %d is not available before block %d", height, height+1)
+	}
+	commitProof.Height = hexutil.Uint64(height + 1) //#nosec G115 -- height is positive
+	commitProof.AppHash = hexutil.Bytes(header.Header.AppHash)
+	return nil
+}
+
+// newStoreProof returns the proof of the value of the key in the store.
+func newStoreProof(store string, key, value []byte, proofOps *crypto.ProofOps) rpctypes.StoreProof {
+	return rpctypes.StoreProof{
+		Store: store,
+		Key:   key,
+		Value: value,
+		Ops:   proof.FromProofOps(proofOps),
+	}
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
@@ -137,6 +281,11 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 
 	res, err := b.QueryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
+		if state, ok := b.historicalState(blockNum); ok {
+			if value, stateErr := state.HistoricalStorage(address, common.HexToHash(key), blockNum.Int64()); stateErr == nil {
+				return value.Bytes(), nil
+			}
+		}
 		return nil, err
 	}
 
@@ -161,21 +310,34 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 	}
 
 	res, err := b.QueryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
-	if err != nil {
-		return nil, err
+	if err == nil {
+		val, ok := sdkmath.NewIntFromString(res.Balance)
+		if !ok {
+			return nil, errors.New("invalid balance")
+		}
+		// balance can only be negative in case of pruned node
+		if !val.IsNegative() {
+			return (*hexutil.Big)(val.BigInt()), nil
+		}
+		err = errors.New("couldn't fetch balance. Node state is pruned")
 	}
 
-	val, ok := sdkmath.NewIntFromString(res.Balance)
-	if !ok {
-		return nil, errors.New("invalid balance")
+	if state, ok := b.historicalState(blockNum); ok {
+		if balance, stateErr := state.HistoricalBalance(address, blockNum.Int64()); stateErr == nil {
+			return (*hexutil.Big)(balance), nil
+		}
 	}
+	return nil, err
+}
 
-	// balance can only be negative in case of pruned node
-	if val.IsNegative() {
-		return nil, errors.New("couldn't fetch balance. Node state is pruned")
+// historicalState returns the historical state recorded by the indexer, if
+// any, to serve the reads of the given block when its state is pruned.
+func (b *Backend) historicalState(blockNum rpctypes.BlockNumber) (servertypes.HistoricalStateReader, bool) {
+	if blockNum <= 0 {
+		return nil, false
 	}
-
-	return (*hexutil.Big)(val.BigInt()), nil
+	state, ok := b.Indexer.(servertypes.HistoricalStateReader)
+	return state, ok
 }
 
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
@@ -19,6 +19,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/monitor"
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	servertypes "github.com/cosmos/evm/server/types"
@@ -56,6 +57,10 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	RPCSendRawTxSyncTimeout() time.Duration
+	RPCFilterCap() int32
+	RPCLogsCap() int32
+	RPCBlockRangeCap() int32
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -72,6 +77,7 @@ type EVMBackend interface {
 	GetBlockTransactionCountByNumber(blockNum types.BlockNumber) *hexutil.Uint
 	CometBlockByNumber(blockNum types.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	CometBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error)
+	CometBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
 	BlockNumberFromComet(blockNrOrHash types.BlockNumberOrHash) (types.BlockNumber, error)
 	BlockNumberFromCometByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromCometBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -88,7 +94,10 @@ type EVMBackend interface {
 	GetBalance(address common.Address, blockNrOrHash types.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash types.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash types.BlockNumberOrHash) (*types.AccountResult, error)
+	GetCommitProof(address common.Address, storageKeys []string, blockNrOrHash types.BlockNumberOrHash) (*types.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum types.BlockNumber) (*hexutil.Uint64, error)
+	GetCodeMetadata(address common.Address, blockNrOrHash types.BlockNumberOrHash) (*types.CodeMetadataResult, error)
+	GetContractCreator(address common.Address) (*types.ContractCreatorResult, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -105,6 +114,8 @@ type EVMBackend interface {
 	GetTransactionByHash(txHash common.Hash) (*types.RPCTransaction, error)
 	GetTxByEthHash(txHash common.Hash) (*servertypes.TxResult, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*servertypes.TxResult, error)
+	GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+	SearchTxHashesByAddress(address common.Address, blockNumber int64, reverse bool, pageSize int) ([]common.Hash, bool, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
@@ -115,9 +126,13 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendPrivateTransaction(args types.PrivateTxArgs) (common.Hash, error)
+	SendBundle(args types.SendBundleArgs) (common.Hash, error)
+	CallBundle(args types.CallBundleArgs) (*types.CallBundleResult, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) (json.RawMessage, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
@@ -135,6 +150,18 @@ type EVMBackend interface {
 	TraceTransaction(hash common.Hash, config *types.TraceConfig) (interface{}, error)
 	TraceBlock(height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) (interface{}, error)
+	TraceCallMany(bundles []types.Bundle, blockNrOrHash types.BlockNumberOrHash, config *types.TraceCallConfig) ([][]interface{}, error)
+	GetBlockTransfers(height int64) ([]*types.Transfer, error)
+
+	// State inspection
+	StorageRangeAt(blockHash common.Hash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (*types.StorageRangeResult, error)
+	GetModifiedAccounts(startHeight, endHeight int64) ([]common.Address, error)
+	AccountRange(blockNrOrHash types.BlockNumberOrHash, start hexutil.Bytes, maxResults int, noCode, noStorage bool) (*types.AccountRangeResult, error)
+
+	// Cosmos
+	GetCosmosTx(hash common.Hash) (*types.CosmosTxResult, error)
+	GetCosmosBalances(address common.Address) ([]types.CosmosBalance, error)
+	GetCosmosDelegations(address common.Address) ([]types.CosmosDelegation, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -173,6 +200,9 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             *evmmempool.ExperimentalEVMMempool
+	// cache holds the responses of the queries of committed blocks, nil if
+	// the response cache is disabled
+	cache *responseCache
 }
 
 func (b *Backend) GetConfig() config.Config {
@@ -202,13 +232,14 @@ func NewBackend(
 		Ctx:                 context.Background(),
 		ClientCtx:           clientCtx,
 		RPCClient:           rpcClient,
-		QueryClient:         types.NewQueryClient(clientCtx),
+		QueryClient:         types.NewQueryClient(monitor.QueryConn(clientCtx)),
 		Logger:              logger.With("module", "backend"),
 		EvmChainID:          new(big.Int).SetUint64(appConf.EVM.EVMChainID),
 		Cfg:                 appConf,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
 		Mempool:             mempool,
+		cache:               newResponseCache(appConf.JSONRPC.ResponseCacheSize),
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b, nil
@@ -47,6 +47,12 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum >= 0 {
+		if res, ok := b.cache.block(blockNum.Int64(), fullTx); ok {
+			return res, nil
+		}
+	}
+
 	resBlock, err := b.CometBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -69,12 +75,17 @@ func (b *Backend) GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (map
 		return nil, err
 	}
 
+	b.cache.addBlock(resBlock.Block.Height, common.BytesToHash(resBlock.BlockID.Hash), fullTx, res)
 	return res, nil
 }
 
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	if res, ok := b.cache.blockByHash(hash, fullTx); ok {
+		return res, nil
+	}
+
 	resBlock, err := b.CometBlockByHash(hash)
 	if err != nil {
 		return nil, err
@@ -97,6 +108,7 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, err
 	}
 
+	b.cache.addBlock(resBlock.Block.Height, hash, fullTx, res)
 	return res, nil
 }
 
@@ -143,7 +155,7 @@ func (b *Backend) getBlockTransactionCount(block *cmtrpctypes.ResultBlock) *hexu
 	}
 
 	ethMsgs := b.EthMsgsFromCometBlock(block, blockRes)
-	n := hexutil.Uint(len(ethMsgs))
+	n := hexutil.Uint(len(ethMsgs) + len(b.cosmosPseudoTxs(block, blockRes)))
 	return &n
 }
 
@@ -221,5 +233,18 @@ func (b *Backend) GetBlockReceipts(
 			return nil, fmt.Errorf("failed to marshal receipt")
 		}
 	}
+
+	// the pseudo transactions of the Cosmos transactions follow the EVM
+	// transactions, without using gas
+	var cumulativeGasUsed uint64
+	if len(receipts) > 0 {
+		cumulativeGasUsed = receipts[len(receipts)-1].CumulativeGasUsed
+	}
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	blockNumber := uint64(resBlock.Block.Height) //nolint:gosec // G115 // heights are positive
+	for i, tx := range b.cosmosPseudoTxs(resBlock, blockRes) {
+		index := uint64(len(msgs) + i) //nolint:gosec // G115 // indexes are positive
+		result = append(result, types.RPCMarshalCosmosPseudoReceipt(tx, blockHash, blockNumber, index, cumulativeGasUsed))
+	}
 	return result, nil
 }
@@ -0,0 +1,211 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// defaultPrivateTxMaxBlocks is the number of blocks a private transaction is
+// held for when no max block number is given.
+const defaultPrivateTxMaxBlocks = 25
+
+// errNoBundleMempool is returned when private transactions or bundles are
+// submitted to a node without the app-side EVM mempool holding them.
+var errNoBundleMempool = errors.New("private transactions and bundles require the app-side EVM mempool")
+
+// SendPrivateTransaction holds a raw Ethereum transaction locally, without
+// broadcasting it, until it is included in a block proposed by this node or
+// the max block number is reached. The max block number can be at most
+// mempool.MaxBundleBlocks after the latest block.
+func (b *Backend) SendPrivateTransaction(args rpctypes.PrivateTxArgs) (common.Hash, error) {
+	if b.Mempool == nil {
+		return common.Hash{}, errNoBundleMempool
+	}
+
+	tx, _, err := b.decodeRawTransaction(args.Tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var maxBlock uint64
+	if args.MaxBlockNumber != nil {
+		maxBlock = uint64(*args.MaxBlockNumber)
+	} else {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return common.Hash{}, err
+		}
+		maxBlock = uint64(latest) + defaultPrivateTxMaxBlocks
+	}
+
+	bundle := &evmmempool.Bundle{
+		Txs:      []*ethtypes.Transaction{tx},
+		MaxBlock: maxBlock,
+	}
+	if _, err := b.Mempool.InsertBundle(bundle); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// SendBundle holds a bundle of raw Ethereum transactions locally, without
+// broadcasting them, to be included all-or-nothing in the block with the
+// given number if this node proposes it. The block number can be at most
+// mempool.MaxBundleBlocks after the latest block.
+func (b *Backend) SendBundle(args rpctypes.SendBundleArgs) (common.Hash, error) {
+	if b.Mempool == nil {
+		return common.Hash{}, errNoBundleMempool
+	}
+	if args.BlockNumber == 0 {
+		return common.Hash{}, errors.New("missing bundle block number")
+	}
+
+	txs, err := b.decodeRawTransactions(args.Txs)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	bundle := &evmmempool.Bundle{
+		Txs:      txs,
+		MinBlock: uint64(args.BlockNumber),
+		MaxBlock: uint64(args.BlockNumber),
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = *args.MinTimestamp
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = *args.MaxTimestamp
+	}
+	return b.Mempool.InsertBundle(bundle)
+}
+
+// CallBundle simulates a bundle of raw Ethereum transactions in order, in the
+// block with the given number and timestamp on top of the state of the given
+// block, and returns the result of each transaction.
+func (b *Backend) CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error) {
+	txs, err := b.decodeRawTransactions(args.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.LatestSigner(b.ChainConfig())
+	calls := make([]evmtypes.TransactionArgs, len(txs))
+	senders := make([]common.Address, len(txs))
+	for i, tx := range txs {
+		if senders[i], err = ethtypes.Sender(signer, tx); err != nil {
+			return nil, err
+		}
+		calls[i] = transactionArgsFromTx(tx, senders[i])
+	}
+
+	overrides := &rpctypes.BlockOverrides{}
+	if args.BlockNumber != 0 {
+		overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(uint64(args.BlockNumber)))
+	}
+	if args.Timestamp != nil {
+		overrides.Time = (*hexutil.Uint64)(args.Timestamp)
+	}
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []rpctypes.SimBlock{{BlockOverrides: overrides, Calls: calls}},
+		Validation:      true,
+	}
+	stateBlock := args.StateBlockNumber
+	bz, err := b.SimulateV1(opts, &rpctypes.BlockNumberOrHash{BlockNumber: &stateBlock})
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []rpctypes.SimBlockResult
+	if err := json.Unmarshal(bz, &blocks); err != nil {
+		return nil, err
+	}
+	// the bundle is simulated in the last block, after the gap filling ones
+	if len(blocks) == 0 || len(blocks[len(blocks)-1].Calls) != len(txs) {
+		return nil, fmt.Errorf("unexpected bundle simulation result")
+	}
+
+	bundle := evmmempool.Bundle{Txs: txs}
+	res := &rpctypes.CallBundleResult{
+		BundleHash:       bundle.Hash(),
+		Results:          make([]rpctypes.CallBundleTxResult, len(txs)),
+		StateBlockNumber: blocks[0].Number - 1,
+	}
+	for i, call := range blocks[len(blocks)-1].Calls {
+		txRes := rpctypes.CallBundleTxResult{
+			TxHash:      txs[i].Hash(),
+			FromAddress: senders[i],
+			ToAddress:   txs[i].To(),
+			GasUsed:     call.GasUsed,
+		}
+		switch {
+		case call.Error == nil:
+			txRes.Value = call.ReturnValue
+		case call.Error.Code == rpctypes.SimulateErrCodeReverted:
+			txRes.Error = "execution reverted"
+			txRes.Revert = call.Error.Message
+		default:
+			txRes.Error = call.Error.Message
+		}
+		res.Results[i] = txRes
+		res.TotalGasUsed += call.GasUsed
+	}
+	return res, nil
+}
+
+// decodeRawTransactions decodes the raw Ethereum transactions of a bundle.
+func (b *Backend) decodeRawTransactions(data []hexutil.Bytes) ([]*ethtypes.Transaction, error) {
+	if len(data) == 0 {
+		return nil, evmmempool.ErrEmptyBundle
+	}
+	txs := make([]*ethtypes.Transaction, len(data))
+	for i, bz := range data {
+		tx, _, err := b.decodeRawTransaction(bz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bundle transaction %d: %w", i, err)
+		}
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
+// transactionArgsFromTx returns the call arguments of a signed transaction.
+func transactionArgsFromTx(tx *ethtypes.Transaction, from common.Address) evmtypes.TransactionArgs {
+	var (
+		gas   = hexutil.Uint64(tx.Gas())
+		nonce = hexutil.Uint64(tx.Nonce())
+		input = hexutil.Bytes(tx.Data())
+	)
+	args := evmtypes.TransactionArgs{
+		From:  &from,
+		To:    tx.To(),
+		Gas:   &gas,
+		Value: (*hexutil.Big)(tx.Value()),
+		Nonce: &nonce,
+		Input: &input,
+	}
+	if tx.Type() == ethtypes.LegacyTxType || tx.Type() == ethtypes.AccessListTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	}
+	if tx.Type() != ethtypes.LegacyTxType {
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+		args.ChainID = (*hexutil.Big)(tx.ChainId())
+	}
+	if tx.Type() == ethtypes.SetCodeTxType {
+		args.AuthorizationList = tx.SetCodeAuthorizations()
+	}
+	return args
+}
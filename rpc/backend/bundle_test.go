@@ -0,0 +1,58 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+func TestTransactionArgsFromTx(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x1")
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9001))
+
+	legacyTx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{
+		Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(10), Value: big.NewInt(5), Data: []byte{1},
+	})
+	require.NoError(t, err)
+	args := transactionArgsFromTx(legacyTx, from)
+	require.Equal(t, from, *args.From)
+	require.Equal(t, to, *args.To)
+	require.Equal(t, hexutil.Uint64(1), *args.Nonce)
+	require.Equal(t, hexutil.Uint64(21000), *args.Gas)
+	require.Equal(t, big.NewInt(10), args.GasPrice.ToInt())
+	require.Equal(t, big.NewInt(5), args.Value.ToInt())
+	require.Equal(t, hexutil.Bytes{1}, *args.Input)
+	require.Nil(t, args.MaxFeePerGas)
+	require.Nil(t, args.AccessList)
+
+	dynamicTx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+		ChainID: big.NewInt(9001), Nonce: 2, To: &to, Gas: 21000, GasFeeCap: big.NewInt(20), GasTipCap: big.NewInt(2),
+	})
+	require.NoError(t, err)
+	args = transactionArgsFromTx(dynamicTx, from)
+	require.Nil(t, args.GasPrice)
+	require.Equal(t, big.NewInt(20), args.MaxFeePerGas.ToInt())
+	require.Equal(t, big.NewInt(2), args.MaxPriorityFeePerGas.ToInt())
+	require.Equal(t, big.NewInt(9001), args.ChainID.ToInt())
+	require.NotNil(t, args.AccessList)
+}
+
+func TestSendBundleWithoutMempool(t *testing.T) {
+	b := &Backend{}
+
+	_, err := b.SendBundle(rpctypes.SendBundleArgs{Txs: []hexutil.Bytes{{1}}, BlockNumber: 1})
+	require.ErrorIs(t, err, errNoBundleMempool)
+
+	_, err = b.SendPrivateTransaction(rpctypes.PrivateTxArgs{Tx: hexutil.Bytes{1}})
+	require.ErrorIs(t, err, errNoBundleMempool)
+}
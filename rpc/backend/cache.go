@@ -0,0 +1,141 @@
+package backend
+
+import (
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// blockKey identifies a cached JSON-RPC block.
+type blockKey struct {
+	height int64
+	fullTx bool
+}
+
+// cache is a size limited LRU cache of the responses of a JSON-RPC query,
+// exporting its hit and miss rates.
+type cache[K comparable, V any] struct {
+	lru  *lru.Cache[K, V]
+	hit  *metrics.Meter
+	miss *metrics.Meter
+	size *metrics.Gauge
+}
+
+func newCache[K comparable, V any](name string, capacity int) *cache[K, V] {
+	return &cache[K, V]{
+		lru:  lru.NewCache[K, V](capacity),
+		hit:  metrics.GetOrRegisterMeter("rpc/cache/"+name+"/hit", nil),
+		miss: metrics.GetOrRegisterMeter("rpc/cache/"+name+"/miss", nil),
+		size: metrics.GetOrRegisterGauge("rpc/cache/"+name+"/size", nil),
+	}
+}
+
+func (c *cache[K, V]) get(key K) (V, bool) {
+	value, ok := c.lru.Get(key)
+	if ok {
+		c.hit.Mark(1)
+	} else {
+		c.miss.Mark(1)
+	}
+	return value, ok
+}
+
+func (c *cache[K, V]) add(key K, value V) {
+	c.lru.Add(key, value)
+	c.size.Update(int64(c.lru.Len()))
+}
+
+// responseCache caches the responses of the JSON-RPC queries of committed
+// blocks. CometBFT blocks are final, so these responses never change once
+// the block and its results are available. The blocks and receipts are
+// returned as shallow copies, so that the callers can set their own fields,
+// but their nested values, as the logs, are shared and must not be modified.
+type responseCache struct {
+	blocks      *cache[blockKey, map[string]interface{}]
+	blockHashes *cache[common.Hash, int64]
+	receipts    *cache[common.Hash, map[string]interface{}]
+	logs        *cache[int64, [][]*ethtypes.Log]
+}
+
+// newResponseCache creates a responseCache holding up to size entries of each
+// query. It returns nil if size is 0, which disables the caching.
+func newResponseCache(size int) *responseCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &responseCache{
+		blocks:      newCache[blockKey, map[string]interface{}]("blocks", size),
+		blockHashes: newCache[common.Hash, int64]("blockhashes", size),
+		receipts:    newCache[common.Hash, map[string]interface{}]("receipts", size),
+		logs:        newCache[int64, [][]*ethtypes.Log]("logs", size),
+	}
+}
+
+// block returns the cached JSON-RPC block at the given height.
+func (c *responseCache) block(height int64, fullTx bool) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	block, ok := c.blocks.get(blockKey{height: height, fullTx: fullTx})
+	return maps.Clone(block), ok
+}
+
+// blockByHash returns the cached JSON-RPC block with the given hash.
+func (c *responseCache) blockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	height, ok := c.blockHashes.get(hash)
+	if !ok {
+		return nil, false
+	}
+	return c.block(height, fullTx)
+}
+
+// addBlock caches the JSON-RPC block with the given height and hash.
+func (c *responseCache) addBlock(height int64, hash common.Hash, fullTx bool, block map[string]interface{}) {
+	if c == nil || block == nil {
+		return
+	}
+	c.blocks.add(blockKey{height: height, fullTx: fullTx}, maps.Clone(block))
+	c.blockHashes.add(hash, height)
+}
+
+// receipt returns the cached JSON-RPC receipt of the given transaction.
+func (c *responseCache) receipt(hash common.Hash) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	receipt, ok := c.receipts.get(hash)
+	return maps.Clone(receipt), ok
+}
+
+// addReceipt caches the JSON-RPC receipt of the given transaction.
+func (c *responseCache) addReceipt(hash common.Hash, receipt map[string]interface{}) {
+	if c == nil || receipt == nil {
+		return
+	}
+	c.receipts.add(hash, maps.Clone(receipt))
+}
+
+// blockLogs returns the cached logs of the transactions of the block at the
+// given height.
+func (c *responseCache) blockLogs(height int64) ([][]*ethtypes.Log, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return c.logs.get(height)
+}
+
+// addBlockLogs caches the logs of the transactions of the block at the given
+// height.
+func (c *responseCache) addBlockLogs(height int64, logs [][]*ethtypes.Log) {
+	if c == nil {
+		return
+	}
+	c.logs.add(height, logs)
+}
@@ -0,0 +1,89 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+)
+
+func TestResponseCache(t *testing.T) {
+	require.Nil(t, newResponseCache(0))
+
+	// a nil cache is disabled
+	var disabled *responseCache
+	disabled.addBlock(1, common.Hash{1}, false, map[string]interface{}{"number": 1})
+	_, ok := disabled.block(1, false)
+	require.False(t, ok)
+
+	c := newResponseCache(2)
+	block := map[string]interface{}{"number": 1}
+	c.addBlock(1, common.Hash{1}, false, block)
+
+	res, ok := c.block(1, false)
+	require.True(t, ok)
+	require.Equal(t, block, res)
+	res, ok = c.blockByHash(common.Hash{1}, false)
+	require.True(t, ok)
+	require.Equal(t, block, res)
+
+	// blocks with full transactions are cached separately
+	_, ok = c.block(1, true)
+	require.False(t, ok)
+	_, ok = c.blockByHash(common.Hash{2}, false)
+	require.False(t, ok)
+
+	// nil receipts of unknown transactions are not cached
+	c.addReceipt(common.Hash{3}, nil)
+	_, ok = c.receipt(common.Hash{3})
+	require.False(t, ok)
+
+	// the cached blocks and receipts are copies, unchanged by the callers
+	res["timestamp"] = "0x1"
+	block["hash"] = "0x2"
+	res, ok = c.block(1, false)
+	require.True(t, ok)
+	require.Equal(t, map[string]interface{}{"number": 1}, res)
+
+	receipt := map[string]interface{}{"status": "0x1"}
+	c.addReceipt(common.Hash{4}, receipt)
+	cachedReceipt, ok := c.receipt(common.Hash{4})
+	require.True(t, ok)
+	cachedReceipt["timestamp"] = "0x1"
+	cachedReceipt, ok = c.receipt(common.Hash{4})
+	require.True(t, ok)
+	require.Equal(t, receipt, cachedReceipt)
+
+	// the least recently used entries are evicted
+	c.addBlock(2, common.Hash{2}, false, map[string]interface{}{"number": 2})
+	c.addBlock(3, common.Hash{3}, false, map[string]interface{}{"number": 3})
+	_, ok = c.block(1, false)
+	require.False(t, ok)
+	_, ok = c.block(3, false)
+	require.True(t, ok)
+}
+
+func TestGetLogsByHeightCached(t *testing.T) {
+	backend := setupMockBackend(t)
+	backend.cache = newResponseCache(8)
+
+	height := int64(5)
+	mockClient := backend.ClientCtx.Client.(*mocks.Client)
+	// the block results are only queried once
+	mockClient.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abcitypes.ExecTxResult{},
+	}, nil).Once()
+
+	logs, err := backend.GetLogsByHeight(&height)
+	require.NoError(t, err)
+	cached, err := backend.GetLogsByHeight(&height)
+	require.NoError(t, err)
+	require.Equal(t, logs, cached)
+}
@@ -102,35 +102,11 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.Logger.Error("transaction decoding failed", "error", err.Error())
+	tx, ethereumTx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
-
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() {
-		if !tx.Protected() {
-			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-		}
-		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
-			return common.Hash{}, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
-		}
-	}
-
-	ethereumTx := &evmtypes.MsgEthereumTx{}
 	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
-	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
-		b.Logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to convert ethereum transaction: %w", err)
-	}
-
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.Logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to validate transaction: %w", err)
-	}
 
 	baseDenom := evmtypes.GetEVMCoinDenom()
 
@@ -193,6 +169,42 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// decodeRawTransaction decodes a raw Ethereum transaction submitted over RPC
+// and converts it to a validated MsgEthereumTx.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*ethtypes.Transaction, *evmtypes.MsgEthereumTx, error) {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.Logger.Error("transaction decoding failed", "error", err.Error())
+		return nil, nil, err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() {
+		if !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return nil, nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
+			return nil, nil, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
+		}
+	}
+
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
+	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
+		b.Logger.Error("transaction converting failed", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to convert ethereum transaction: %w", err)
+	}
+
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.Logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to validate transaction: %w", err)
+	}
+
+	return tx, ethereumTx, nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -435,6 +447,61 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 simulates a sequence of calls across several blocks on top of the
+// state of the given block and returns the json encoded simulated blocks.
+func (b *Backend) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (json.RawMessage, error) {
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNr, err = b.BlockNumberFromComet(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CometHeaderByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Data, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -46,7 +46,12 @@ func (b *Backend) RPCBlockFromCometBlock(
 		return nil, fmt.Errorf("failed to get rpc block from comet block: %w", err)
 	}
 
-	return rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, b.ChainConfig())
+	fields, err := rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, b.ChainConfig())
+	if err != nil {
+		return nil, err
+	}
+	b.appendCosmosPseudoTxs(fields, resBlock, blockRes, len(msgs), fullTx)
+	return fields, nil
 }
 
 // BlockNumberFromComet returns the BlockNumber from BlockNumberOrHash
@@ -0,0 +1,158 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetCosmosTx returns the Cosmos transaction wrapping the Ethereum transaction
+// with the given hash, with its execution result. It returns nil if the
+// transaction is not found.
+func (b *Backend) GetCosmosTx(hash common.Hash) (*rpctypes.CosmosTxResult, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.Logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+	if res == nil {
+		return nil, nil
+	}
+
+	block, err := b.CometBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || int(res.TxIndex) >= len(block.Block.Txs) {
+		return nil, fmt.Errorf("tx %s not found in block %d", hash.Hex(), res.Height)
+	}
+
+	blockRes, err := b.CometBlockResultByNumber(&res.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d: %w", res.Height, err)
+	}
+	if int(res.TxIndex) >= len(blockRes.TxsResults) {
+		return nil, fmt.Errorf("tx %s result not found in block %d", hash.Hex(), res.Height)
+	}
+
+	txBz := block.Block.Txs[res.TxIndex]
+	tx, err := b.ClientCtx.TxConfig.TxDecoder()(txBz)
+	if err != nil {
+		return nil, err
+	}
+	txJSON, err := b.ClientCtx.TxConfig.TxJSONEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	txResult := blockRes.TxsResults[res.TxIndex]
+	return &rpctypes.CosmosTxResult{
+		Hash:             fmt.Sprintf("%X", cmttypes.Tx(txBz).Hash()),
+		BlockHash:        common.BytesToHash(block.BlockID.Hash),
+		BlockNumber:      hexutil.Uint64(res.Height), //nolint:gosec // G115 // block height is not negative
+		TransactionIndex: hexutil.Uint64(res.TxIndex),
+		Tx:               txJSON,
+		Code:             txResult.Code,
+		Codespace:        txResult.Codespace,
+		Log:              txResult.Log,
+		GasWanted:        hexutil.Uint64(txResult.GasWanted), //nolint:gosec // G115 // gas is not negative
+		GasUsed:          hexutil.Uint64(txResult.GasUsed),   //nolint:gosec // G115 // gas is not negative
+		Events:           txResult.Events,
+	}, nil
+}
+
+// GetCosmosBalances returns the bank balances of the given address, with the
+// addresses of the ERC20 tokens the denoms are mapped to. The ERC20 addresses
+// are omitted if the token pairs can't be queried.
+func (b *Backend) GetCosmosBalances(address common.Address) ([]rpctypes.CosmosBalance, error) {
+	erc20Addresses, err := b.erc20Addresses()
+	if err != nil {
+		b.Logger.Debug("failed to query the token pairs", "error", err.Error())
+	}
+
+	balances := []rpctypes.CosmosBalance{}
+	req := &banktypes.QueryAllBalancesRequest{
+		Address:    sdk.AccAddress(address.Bytes()).String(),
+		Pagination: &query.PageRequest{},
+	}
+	for {
+		res, err := b.QueryClient.Bank.AllBalances(b.Ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, coin := range res.Balances {
+			balance := rpctypes.CosmosBalance{
+				Denom:  coin.Denom,
+				Amount: (*hexutil.Big)(coin.Amount.BigInt()),
+			}
+			if erc20Address, ok := erc20Addresses[coin.Denom]; ok {
+				balance.ERC20Address = &erc20Address
+			}
+			balances = append(balances, balance)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// erc20Addresses returns the addresses of the ERC20 tokens of the enabled token
+// pairs, indexed by denom.
+func (b *Backend) erc20Addresses() (map[string]common.Address, error) {
+	addresses := make(map[string]common.Address)
+	req := &erc20types.QueryTokenPairsRequest{Pagination: &query.PageRequest{}}
+	for {
+		res, err := b.QueryClient.Erc20.TokenPairs(b.Ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range res.TokenPairs {
+			if pair.Enabled {
+				addresses[pair.Denom] = common.HexToAddress(pair.Erc20Address)
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return addresses, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// GetCosmosDelegations returns the staking delegations of the given address.
+func (b *Backend) GetCosmosDelegations(address common.Address) ([]rpctypes.CosmosDelegation, error) {
+	delegations := []rpctypes.CosmosDelegation{}
+	req := &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: sdk.AccAddress(address.Bytes()).String(),
+		Pagination:    &query.PageRequest{},
+	}
+	for {
+		res, err := b.QueryClient.Staking.DelegatorDelegations(b.Ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, delegation := range res.DelegationResponses {
+			delegations = append(delegations, rpctypes.CosmosDelegation{
+				Validator: delegation.Delegation.ValidatorAddress,
+				Shares:    delegation.Delegation.Shares.String(),
+				Denom:     delegation.Balance.Denom,
+				Amount:    (*hexutil.Big)(delegation.Balance.Amount.BigInt()),
+			})
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return delegations, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
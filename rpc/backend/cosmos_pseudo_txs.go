@@ -0,0 +1,252 @@
+package backend
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// cosmosPseudoTxs returns the pseudo transactions of the balance changes of
+// the EVM coin made by the Cosmos transactions of a block, following the EVM
+// transactions of the block. It returns nil unless the pseudo transactions are
+// enabled.
+//
+// The balance changes of a Cosmos transaction are the net changes of the
+// balances of the accounts, from the coin spent and received events of the
+// bank and the fractional balance change events of the precisebank. Each
+// debited account is matched with the credited accounts in event order, so
+// that a transfer from a single account, e.g. a bank send paying fees, is
+// synthesized as transfers from this account, and the changes left over are
+// synthesized as transfers from or to the zero address.
+func (b *Backend) cosmosPseudoTxs(resBlock *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults) []*rpctypes.CosmosPseudoTx {
+	if !b.Cfg.JSONRPC.EnableCosmosPseudoTxs {
+		return nil
+	}
+
+	var pseudoTxs []*rpctypes.CosmosPseudoTx
+	txDecoder := b.ClientCtx.TxConfig.TxDecoder()
+	for i, txBz := range resBlock.Block.Txs {
+		if i >= len(blockRes.TxsResults) {
+			break
+		}
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			b.Logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+		if isEthereumTx(tx) {
+			continue
+		}
+
+		cosmosHash := common.BytesToHash(txBz.Hash())
+		for _, transfer := range balanceChangeTransfers(blockRes.TxsResults[i].Events) {
+			transfer.CosmosTxHash = cosmosHash
+			transfer.Hash = cosmosPseudoTxHash(resBlock.Block.Height, cosmosHash, len(pseudoTxs))
+			pseudoTxs = append(pseudoTxs, transfer)
+		}
+	}
+	return pseudoTxs
+}
+
+// appendCosmosPseudoTxs appends the pseudo transactions of a block to the
+// transactions of its RPC representation, as hashes or as full transactions.
+func (b *Backend) appendCosmosPseudoTxs(fields map[string]interface{}, resBlock *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults, ethTxs int, fullTx bool) {
+	transactions, ok := fields["transactions"].([]interface{})
+	if !ok {
+		return
+	}
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	blockNumber := uint64(resBlock.Block.Height) //nolint:gosec // G115 // heights are positive
+	for i, tx := range b.cosmosPseudoTxs(resBlock, blockRes) {
+		if !fullTx {
+			transactions = append(transactions, tx.Hash)
+			continue
+		}
+		index := uint64(ethTxs + i) //nolint:gosec // G115 // indexes are positive
+		transactions = append(transactions, rpctypes.NewRPCCosmosPseudoTx(tx, blockHash, blockNumber, index, b.EvmChainID))
+	}
+	fields["transactions"] = transactions
+}
+
+// cosmosPseudoTxByHash returns the pseudo transaction with the given hash, its
+// index among the pseudo transactions of its block, and the block.
+func (b *Backend) cosmosPseudoTxByHash(hash common.Hash) (*rpctypes.CosmosPseudoTx, int, *cmtrpctypes.ResultBlock, *cmtrpctypes.ResultBlockResults, bool) {
+	if !b.Cfg.JSONRPC.EnableCosmosPseudoTxs {
+		return nil, 0, nil, nil, false
+	}
+	// the hash of a pseudo transaction starts with the height of its block,
+	// so that it is found without an index
+	height := binary.BigEndian.Uint64(hash[:8])
+	if height == 0 || height >= 1<<48 {
+		return nil, 0, nil, nil, false
+	}
+
+	resBlock, err := b.CometBlockByNumber(rpctypes.BlockNumber(height)) //nolint:gosec // G115 // checked above
+	if err != nil || resBlock == nil {
+		return nil, 0, nil, nil, false
+	}
+	blockRes, err := b.CometBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, 0, nil, nil, false
+	}
+	for i, tx := range b.cosmosPseudoTxs(resBlock, blockRes) {
+		if tx.Hash == hash {
+			return tx, i, resBlock, blockRes, true
+		}
+	}
+	return nil, 0, nil, nil, false
+}
+
+// getCosmosPseudoTx returns the transaction of the pseudo transaction with
+// the given hash, if any.
+func (b *Backend) getCosmosPseudoTx(hash common.Hash) (*rpctypes.RPCTransaction, bool) {
+	tx, i, resBlock, blockRes, ok := b.cosmosPseudoTxByHash(hash)
+	if !ok {
+		return nil, false
+	}
+	index := uint64(len(b.EthMsgsFromCometBlock(resBlock, blockRes)) + i) //nolint:gosec // G115 // indexes are positive
+	blockNumber := uint64(resBlock.Block.Height)                          //nolint:gosec // G115 // heights are positive
+	return rpctypes.NewRPCCosmosPseudoTx(tx, common.BytesToHash(resBlock.BlockID.Hash), blockNumber, index, b.EvmChainID), true
+}
+
+// getCosmosPseudoReceipt returns the receipt of the pseudo transaction with
+// the given hash, if any.
+func (b *Backend) getCosmosPseudoReceipt(hash common.Hash) (map[string]interface{}, bool, error) {
+	tx, i, resBlock, blockRes, ok := b.cosmosPseudoTxByHash(hash)
+	if !ok {
+		return nil, false, nil
+	}
+	receipts, err := b.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: (*rpctypes.BlockNumber)(&resBlock.Block.Height)})
+	if err != nil {
+		return nil, false, err
+	}
+	index := len(b.EthMsgsFromCometBlock(resBlock, blockRes)) + i
+	if index >= len(receipts) || receipts[index]["transactionHash"] != tx.Hash {
+		return nil, false, nil
+	}
+	return receipts[index], true, nil
+}
+
+// cosmosPseudoTxHash returns the hash of the pseudo transaction at the given
+// index of a block: the height of the block followed by the end of the hash of
+// the Cosmos transaction and the index.
+func cosmosPseudoTxHash(height int64, cosmosHash common.Hash, index int) common.Hash {
+	var hash common.Hash
+	binary.BigEndian.PutUint64(hash[:8], uint64(height))                                                    //nolint:gosec // G115 // heights are positive
+	copy(hash[8:], crypto.Keccak256(cosmosHash.Bytes(), binary.BigEndian.AppendUint32(nil, uint32(index)))) //nolint:gosec // G115 // indexes are small
+	return hash
+}
+
+// isEthereumTx returns true if the transaction holds Ethereum transactions,
+// whose balance changes are the ones of the Ethereum transactions.
+func isEthereumTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// balanceChange is the net change of the balance of an account, in 18
+// decimals.
+type balanceChange struct {
+	address common.Address
+	amount  *big.Int
+}
+
+// balanceChangeTransfers returns the transfers of the net balance changes of
+// the EVM coin in the events of a transaction.
+func balanceChangeTransfers(events []abci.Event) []*rpctypes.CosmosPseudoTx {
+	denom := evmtypes.GetEVMCoinDenom()
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+
+	var changes []*balanceChange
+	add := func(bech32 string, amount sdkmath.Int) {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil || len(addr) != common.AddressLength || amount.IsZero() {
+			return
+		}
+		address := common.BytesToAddress(addr)
+		for _, change := range changes {
+			if change.address == address {
+				change.amount.Add(change.amount, amount.BigInt())
+				return
+			}
+		}
+		changes = append(changes, &balanceChange{address: address, amount: amount.BigInt()})
+	}
+	coinAmount := func(event abci.Event) sdkmath.Int {
+		coins, err := sdk.ParseCoinsNormalized(eventAttribute(event, sdk.AttributeKeyAmount))
+		if err != nil {
+			return sdkmath.ZeroInt()
+		}
+		return coins.AmountOf(denom).Mul(factor)
+	}
+
+	for _, event := range events {
+		switch event.Type {
+		case banktypes.EventTypeCoinReceived:
+			add(eventAttribute(event, banktypes.AttributeKeyReceiver), coinAmount(event))
+		case banktypes.EventTypeCoinSpent:
+			add(eventAttribute(event, banktypes.AttributeKeySpender), coinAmount(event).Neg())
+		case precisebanktypes.EventTypeFractionalBalanceChange:
+			if delta, ok := sdkmath.NewIntFromString(eventAttribute(event, precisebanktypes.AttributeKeyDelta)); ok {
+				add(eventAttribute(event, precisebanktypes.AttributeKeyAddress), delta)
+			}
+		}
+	}
+
+	var debits, credits []*balanceChange
+	for _, change := range changes {
+		switch change.amount.Sign() {
+		case -1:
+			debits = append(debits, &balanceChange{address: change.address, amount: new(big.Int).Neg(change.amount)})
+		case 1:
+			credits = append(credits, change)
+		}
+	}
+
+	var transfers []*rpctypes.CosmosPseudoTx
+	for len(debits) > 0 || len(credits) > 0 {
+		transfer := &rpctypes.CosmosPseudoTx{}
+		switch {
+		case len(debits) == 0:
+			transfer.To, transfer.Value = credits[0].address, credits[0].amount
+			credits = credits[1:]
+		case len(credits) == 0:
+			transfer.From, transfer.Value = debits[0].address, debits[0].amount
+			debits = debits[1:]
+		default:
+			transfer.From, transfer.To = debits[0].address, credits[0].address
+			transfer.Value = new(big.Int).Set(debits[0].amount)
+			if credits[0].amount.Cmp(transfer.Value) < 0 {
+				transfer.Value.Set(credits[0].amount)
+			}
+			debits[0].amount.Sub(debits[0].amount, transfer.Value)
+			credits[0].amount.Sub(credits[0].amount, transfer.Value)
+			if debits[0].amount.Sign() == 0 {
+				debits = debits[1:]
+			}
+			if credits[0].amount.Sign() == 0 {
+				credits = credits[1:]
+			}
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
@@ -0,0 +1,113 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func coinEvent(eventType, key string, addr sdk.AccAddress, amount string) abcitypes.Event {
+	return abcitypes.Event{Type: eventType, Attributes: []abcitypes.EventAttribute{
+		{Key: key, Value: addr.String()},
+		{Key: sdk.AttributeKeyAmount, Value: amount},
+	}}
+}
+
+func TestCosmosPseudoTxs(t *testing.T) {
+	backend := setupMockBackend(t)
+	backend.Cfg.JSONRPC.EnableCosmosPseudoTxs = true
+	height := int64(5)
+
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	backend.ClientCtx = backend.ClientCtx.WithTxConfig(encodingConfig.TxConfig)
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(alice.Bytes(), bob.Bytes(), nil)))
+	cosmosTxBz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// alice pays 2 of fees and sends 10 to bob, bob burns 4 of other coins
+	denom := evmtypes.GetEVMCoinDenom()
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor().Int64()
+	events := []abcitypes.Event{
+		coinEvent(banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, alice.Bytes(), "2"+denom),
+		coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, feeCollector, "2"+denom),
+		coinEvent(banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, alice.Bytes(), "10"+denom),
+		coinEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, bob.Bytes(), "10"+denom+",3uosmo"),
+		coinEvent(banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, bob.Bytes(), "4uosmo"),
+	}
+
+	client := backend.ClientCtx.Client.(*mocks.Client)
+	resBlock := &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: common.HexToHash("0xb10c").Bytes()},
+		Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: height},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{cosmosTxBz}},
+		},
+	}
+	client.On("Block", mock.Anything, &height).Return(resBlock, nil)
+	client.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abcitypes.ExecTxResult{{Events: events}},
+	}, nil)
+	backend.QueryClient.QueryClient.(*mocks.EVMQueryClient).On("BaseFee", mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryBaseFeeResponse{BaseFee: nil}, nil)
+
+	cosmosHash := common.BytesToHash(tmtypes.Tx(cosmosTxBz).Hash())
+	expected := []*rpctypes.CosmosPseudoTx{
+		{From: alice, To: common.BytesToAddress(feeCollector), Value: big.NewInt(2 * factor)},
+		{From: alice, To: bob, Value: big.NewInt(10 * factor)},
+	}
+	for i, tx := range expected {
+		tx.CosmosTxHash = cosmosHash
+		tx.Hash = cosmosPseudoTxHash(height, cosmosHash, i)
+	}
+	pseudoTxs := backend.cosmosPseudoTxs(resBlock, &tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abcitypes.ExecTxResult{{Events: events}},
+	})
+	require.Equal(t, expected, pseudoTxs)
+
+	tx, err := backend.GetTransactionByHash(expected[1].Hash)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(rpctypes.CosmosTxType), tx.Type)
+	require.Equal(t, alice, tx.From)
+	require.Equal(t, &bob, tx.To)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(10*factor)), tx.Value)
+	require.Equal(t, &cosmosHash, tx.CosmosTxHash)
+	require.Equal(t, hexutil.Uint64(1), *tx.TransactionIndex)
+
+	receipt, err := backend.GetTransactionReceipt(expected[1].Hash)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint(1), receipt["status"])
+	require.Equal(t, expected[1].Hash, receipt["transactionHash"])
+
+	// the pseudo transactions are off by default
+	backend.Cfg.JSONRPC.EnableCosmosPseudoTxs = false
+	require.Empty(t, backend.cosmosPseudoTxs(resBlock, &tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abcitypes.ExecTxResult{{Events: events}},
+	}))
+}
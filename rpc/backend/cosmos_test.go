@@ -0,0 +1,186 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// fakeBankQueryClient returns the balances in pages of one coin.
+type fakeBankQueryClient struct {
+	banktypes.QueryClient
+	balances sdk.Coins
+}
+
+func (c *fakeBankQueryClient) AllBalances(_ context.Context, req *banktypes.QueryAllBalancesRequest, _ ...grpc.CallOption) (*banktypes.QueryAllBalancesResponse, error) {
+	page := 0
+	if len(req.Pagination.Key) > 0 {
+		page = int(req.Pagination.Key[0])
+	}
+	res := &banktypes.QueryAllBalancesResponse{
+		Balances:   c.balances[page : page+1],
+		Pagination: &query.PageResponse{},
+	}
+	if page+1 < len(c.balances) {
+		res.Pagination.NextKey = []byte{byte(page + 1)}
+	}
+	return res, nil
+}
+
+type fakeErc20QueryClient struct {
+	erc20types.QueryClient
+	pairs []erc20types.TokenPair
+	err   error
+}
+
+func (c *fakeErc20QueryClient) TokenPairs(context.Context, *erc20types.QueryTokenPairsRequest, ...grpc.CallOption) (*erc20types.QueryTokenPairsResponse, error) {
+	return &erc20types.QueryTokenPairsResponse{TokenPairs: c.pairs}, c.err
+}
+
+type fakeStakingQueryClient struct {
+	stakingtypes.QueryClient
+	delegations stakingtypes.DelegationResponses
+}
+
+func (c *fakeStakingQueryClient) DelegatorDelegations(_ context.Context, req *stakingtypes.QueryDelegatorDelegationsRequest, _ ...grpc.CallOption) (*stakingtypes.QueryDelegatorDelegationsResponse, error) {
+	delegations := stakingtypes.DelegationResponses{}
+	for _, delegation := range c.delegations {
+		if delegation.Delegation.DelegatorAddress == req.DelegatorAddr {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return &stakingtypes.QueryDelegatorDelegationsResponse{DelegationResponses: delegations}, nil
+}
+
+func TestGetCosmosBalances(t *testing.T) {
+	backend := setupMockBackend(t)
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	erc20Address := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	backend.QueryClient.Bank = &fakeBankQueryClient{balances: sdk.NewCoins(
+		sdk.NewInt64Coin("aatom", 100),
+		sdk.NewInt64Coin("ibc/ABC", 20),
+		sdk.NewInt64Coin("uosmo", 3),
+	)}
+	erc20Client := &fakeErc20QueryClient{pairs: []erc20types.TokenPair{
+		{Denom: "ibc/ABC", Erc20Address: erc20Address.Hex(), Enabled: true},
+		{Denom: "uosmo", Erc20Address: common.HexToAddress("0x03").Hex(), Enabled: false},
+	}}
+	backend.QueryClient.Erc20 = erc20Client
+
+	balances, err := backend.GetCosmosBalances(address)
+	require.NoError(t, err)
+	require.Len(t, balances, 3)
+	require.Equal(t, "aatom", balances[0].Denom)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(100)), balances[0].Amount)
+	require.Nil(t, balances[0].ERC20Address)
+	require.Equal(t, "ibc/ABC", balances[1].Denom)
+	require.Equal(t, &erc20Address, balances[1].ERC20Address)
+	require.Nil(t, balances[2].ERC20Address, "disabled token pair")
+
+	// the balances are returned without the token pairs
+	erc20Client.err = errors.New("unknown service")
+	balances, err = backend.GetCosmosBalances(address)
+	require.NoError(t, err)
+	require.Len(t, balances, 3)
+	require.Nil(t, balances[1].ERC20Address)
+}
+
+func TestGetCosmosDelegations(t *testing.T) {
+	backend := setupMockBackend(t)
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	backend.QueryClient.Staking = &fakeStakingQueryClient{delegations: stakingtypes.DelegationResponses{
+		stakingtypes.NewDelegationResp(sdk.AccAddress(address.Bytes()).String(), "cosmosvaloper1", math.LegacyNewDec(5), sdk.NewInt64Coin("aatom", 5)),
+		stakingtypes.NewDelegationResp(sdk.AccAddress(common.Address{}.Bytes()).String(), "cosmosvaloper2", math.LegacyNewDec(7), sdk.NewInt64Coin("aatom", 7)),
+	}}
+
+	delegations, err := backend.GetCosmosDelegations(address)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Equal(t, "cosmosvaloper1", delegations[0].Validator)
+	require.Equal(t, math.LegacyNewDec(5).String(), delegations[0].Shares)
+	require.Equal(t, "aatom", delegations[0].Denom)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(5)), delegations[0].Amount)
+
+	delegations, err = backend.GetCosmosDelegations(common.HexToAddress("0x03"))
+	require.NoError(t, err)
+	require.Empty(t, delegations)
+}
+
+func TestGetCosmosTx(t *testing.T) {
+	backend := setupMockBackend(t)
+	height := int64(5)
+
+	msg := buildMsgEthereumTx(t)
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	backend.ClientCtx = backend.ClientCtx.WithTxConfig(encodingConfig.TxConfig)
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	backend.Indexer = &MockIndexer{txResults: map[common.Hash]*servertypes.TxResult{
+		msg.Hash(): {Height: height, TxIndex: 1},
+	}}
+
+	// not found
+	res, err := backend.GetCosmosTx(common.Hash{1})
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	blockHash := common.Hash{2}
+	client := backend.ClientCtx.Client.(*mocks.Client)
+	client.On("Block", mock.Anything, &height).Return(&tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: blockHash.Bytes()},
+		Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: height},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{{0x1}, txBz}},
+		},
+	}, nil)
+	client.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{
+		Height: height,
+		TxsResults: []*abcitypes.ExecTxResult{
+			{},
+			{Code: 0, GasWanted: 100000, GasUsed: 21000, Events: []abcitypes.Event{{Type: "ethereum_tx"}}},
+		},
+	}, nil)
+
+	res, err = backend.GetCosmosTx(msg.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, tmtypes.Tx(txBz).Hash(), common.FromHex(res.Hash))
+	require.Equal(t, blockHash, res.BlockHash)
+	require.Equal(t, hexutil.Uint64(height), res.BlockNumber)
+	require.Equal(t, hexutil.Uint64(1), res.TransactionIndex)
+	require.Equal(t, hexutil.Uint64(21000), res.GasUsed)
+	require.Equal(t, hexutil.Uint64(100000), res.GasWanted)
+	require.Len(t, res.Events, 1)
+	require.Contains(t, string(res.Tx), "/cosmos.evm.vm.v1.MsgEthereumTx")
+}
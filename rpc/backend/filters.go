@@ -20,13 +20,35 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if height != nil {
+		if logs, ok := b.cache.blockLogs(*height); ok {
+			return logs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.RPCClient.BlockResults(b.Ctx, height)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetLogsFromBlockResults(blockRes)
+	logs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cache.addBlockLogs(blockRes.Height, logs)
+	return logs, nil
+}
+
+// BlocksWithLogs returns the blocks within [from, to] which may contain logs
+// matching the addresses and topics, looked up in the log index of the
+// indexer. The returned bool is false if the range is not indexed.
+func (b *Backend) BlocksWithLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	if b.Indexer == nil {
+		return nil, false, nil
+	}
+	return b.Indexer.BlocksWithLogs(from, to, addresses, topics)
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
@@ -312,6 +312,12 @@ func (b *Backend) RPCEVMTimeout() time.Duration {
 	return b.Cfg.JSONRPC.EVMTimeout
 }
 
+// RPCSendRawTxSyncTimeout is the maximum time eth_sendRawTransactionSync waits
+// for the inclusion of a transaction.
+func (b *Backend) RPCSendRawTxSyncTimeout() time.Duration {
+	return b.Cfg.JSONRPC.SendRawTxSyncTimeout
+}
+
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCTxFeeCap() float64 {
 	return b.Cfg.JSONRPC.TxFeeCap
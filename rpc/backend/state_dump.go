@@ -0,0 +1,241 @@
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// modifiedAccountsTraceConfig traces the accounts written by the transactions
+// of a block.
+var modifiedAccountsTraceConfig = &rpctypes.TraceConfig{
+	TraceConfig:  evmtypes.TraceConfig{Tracer: "prestateTracer"},
+	TracerConfig: json.RawMessage(`{"diffMode":true}`),
+}
+
+// StorageRangeAt returns a page of the storage of the given contract, in
+// storage key order, as seen before the execution of the transaction at the
+// given index of the block. Unlike go-ethereum, the storage is not iterated in
+// the order of the hashed keys.
+func (b *Backend) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (*rpctypes.StorageRangeResult, error) {
+	if maxResult < 0 {
+		return nil, fmt.Errorf("invalid max result %d", maxResult)
+	}
+
+	blk, err := b.CometBlockByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	blockRes, err := b.CometBlockResultByNumber(&blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromCometBlock(blk, blockRes)
+	if txIndex < 0 || txIndex > len(msgs) {
+		return nil, fmt.Errorf("transaction index %d out of range for block %s", txIndex, blockHash.Hex())
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address:         address.Hex(),
+		KeyStart:        keyStart,
+		MaxResult:       uint64(maxResult),
+		Predecessors:    msgs[:txIndex],
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := blk.Block.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	res, err := b.QueryClient.StorageRange(rpctypes.ContextWithHeight(contextHeight), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.StorageRangeResult{Storage: make(rpctypes.StorageMap, len(res.Storage))}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if len(res.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// GetModifiedAccounts returns the addresses of the accounts modified by the
+// blocks after startHeight up to endHeight included. They are read from the
+// historical state store when it covers the blocks, which records the writes
+// of both the EVM and the Cosmos txs, up to the block range cap. Otherwise
+// the EVM transactions of the blocks are traced, up to the trace block range
+// cap, missing the accounts only modified by Cosmos txs.
+func (b *Backend) GetModifiedAccounts(startHeight, endHeight int64) ([]common.Address, error) {
+	if startHeight >= endHeight {
+		return nil, fmt.Errorf("start block height (%d) must be less than end block height (%d)", startHeight, endHeight)
+	}
+
+	if state, ok := b.Indexer.(servertypes.HistoricalStateReader); ok {
+		if rangeCap := b.Cfg.JSONRPC.BlockRangeCap; rangeCap > 0 && endHeight-startHeight > int64(rangeCap) {
+			return nil, fmt.Errorf("block range exceeds the maximum of %d blocks", rangeCap)
+		}
+		addresses, err := state.ModifiedAccounts(startHeight, endHeight)
+		if !errors.Is(err, indexer.ErrStateNotAvailable) {
+			return addresses, err
+		}
+	}
+
+	if rangeCap := b.Cfg.JSONRPC.TraceBlockRangeCap; rangeCap > 0 && endHeight-startHeight > int64(rangeCap) {
+		return nil, fmt.Errorf("block range exceeds the maximum of %d traced blocks", rangeCap)
+	}
+
+	modified := make(map[common.Address]struct{})
+	for height := startHeight + 1; height <= endHeight; height++ {
+		blk, err := b.CometBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil || blk.Block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+
+		results, err := b.TraceBlock(rpctypes.BlockNumber(height), modifiedAccountsTraceConfig, blk)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range results {
+			if res == nil || res.Error != "" {
+				continue
+			}
+			bz, err := json.Marshal(res.Result)
+			if err != nil {
+				return nil, err
+			}
+			var diff rpctypes.PrestateDiff
+			if err := json.Unmarshal(bz, &diff); err != nil {
+				return nil, err
+			}
+			// the pre state of the diff mode only holds modified accounts,
+			// and the post state the created ones on top of them
+			for addr := range diff.Pre {
+				modified[addr] = struct{}{}
+			}
+			for addr := range diff.Post {
+				modified[addr] = struct{}{}
+			}
+		}
+	}
+
+	addresses := make([]common.Address, 0, len(modified))
+	for addr := range modified {
+		addresses = append(addresses, addr)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	})
+	return addresses, nil
+}
+
+// AccountRange returns a page of the state of the accounts at the given
+// block, in address order, starting from the given address.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	noCode, noStorage bool,
+) (*rpctypes.AccountRangeResult, error) {
+	if maxResults < 0 {
+		return nil, fmt.Errorf("invalid max results %d", maxResults)
+	}
+
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Start:      start,
+		MaxResults: uint64(maxResults),
+		NoCode:     noCode,
+		NoStorage:  noStorage,
+	}
+
+	res, err := b.QueryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.AccountRangeResult{
+		Accounts: make(map[common.Address]rpctypes.DumpAccount, len(res.Accounts)),
+	}
+	for _, acc := range res.Accounts {
+		addr := common.HexToAddress(acc.Address)
+		dump := rpctypes.DumpAccount{
+			Balance:  acc.Balance,
+			Nonce:    acc.Nonce,
+			CodeHash: common.HexToHash(acc.CodeHash).Bytes(),
+			Code:     acc.Code,
+			Address:  &addr,
+		}
+		if len(acc.Storage) > 0 {
+			dump.Storage = make(map[common.Hash]common.Hash, len(acc.Storage))
+			for _, state := range acc.Storage {
+				dump.Storage[common.HexToHash(state.Key)] = common.HexToHash(state.Value)
+			}
+		}
+		result.Accounts[addr] = dump
+	}
+	if len(res.Next) > 0 {
+		result.Next = res.Next
+	}
+
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/server/config"
+	servertypes "github.com/cosmos/evm/server/types"
+)
+
+// stateIndexer is an indexer recording the accounts modified by the blocks
+// up to its latest block.
+type stateIndexer struct {
+	servertypes.EVMTxIndexer
+	servertypes.HistoricalStateReader
+	latest   int64
+	modified []common.Address
+}
+
+func (idx *stateIndexer) ModifiedAccounts(_, endHeight int64) ([]common.Address, error) {
+	if endHeight > idx.latest {
+		return nil, indexer.ErrStateNotAvailable
+	}
+	return idx.modified, nil
+}
+
+func TestGetModifiedAccountsFromStateStore(t *testing.T) {
+	modified := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	b := &Backend{
+		Indexer: &stateIndexer{latest: 100, modified: modified},
+		Cfg:     config.Config{JSONRPC: config.JSONRPCConfig{BlockRangeCap: 50, TraceBlockRangeCap: 5}},
+	}
+
+	// the state store serves the ranges up to the block range cap
+	addresses, err := b.GetModifiedAccounts(10, 60)
+	require.NoError(t, err)
+	require.Equal(t, modified, addresses)
+
+	_, err = b.GetModifiedAccounts(10, 61)
+	require.ErrorContains(t, err, "maximum of 50 blocks")
+
+	// the blocks not covered by the store are traced, up to the trace cap
+	_, err = b.GetModifiedAccounts(100, 106)
+	require.ErrorContains(t, err, "maximum of 5 traced blocks")
+
+	_, err = b.GetModifiedAccounts(10, 10)
+	require.ErrorContains(t, err, "must be less than")
+}
@@ -296,3 +296,97 @@ func (b *Backend) TraceCall(
 
 	return decodedResult, nil
 }
+
+// TraceCallMany traces the calls of the given bundles sequentially on top of
+// the state of the given block, each call seeing the state changes of the
+// previous ones. It returns the traces of the calls of each bundle. The block
+// overrides of the bundles are not supported.
+func (b *Backend) TraceCallMany(
+	bundles []rpctypes.Bundle,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) ([][]interface{}, error) {
+	if len(bundles) > rpctypes.MaxTraceCallManyBundles {
+		return nil, fmt.Errorf("too many bundles: the maximum is %d", rpctypes.MaxTraceCallManyBundles)
+	}
+
+	var calls [][]byte
+	for i, bundle := range bundles {
+		if bundle.BlockOverride != nil {
+			return nil, fmt.Errorf("bundle %d: block overrides are not supported", i)
+		}
+		if len(calls)+len(bundle.Transactions) > rpctypes.MaxTraceCallManyCalls {
+			return nil, fmt.Errorf("too many calls: the maximum is %d", rpctypes.MaxTraceCallManyCalls)
+		}
+		for _, args := range bundle.Transactions {
+			bz, err := json.Marshal(&args)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, bz)
+		}
+	}
+	if len(calls) == 0 {
+		return nil, errors.New("empty bundles")
+	}
+
+	// Get block number from blockNrOrHash
+	blockNr, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the block to get necessary context
+	header, err := b.CometHeaderByNumber(blockNr)
+	if err != nil {
+		b.Logger.Debug("block not found", "number", blockNr)
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Calls:           calls,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		BlockNumber:     header.Header.Height,
+		BlockHash:       common.Bytes2Hex(header.Header.Hash()),
+		BlockTime:       header.Header.Time,
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = b.convertConfig(&config.TraceConfig)
+		if config.StateOverrides != nil {
+			if traceCallRequest.StateOverrides, err = json.Marshal(config.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// get the context of provided block
+	contextHeight := header.Header.Height
+	if contextHeight < 1 {
+		contextHeight = 1
+	}
+
+	traceResult, err := b.QueryClient.TraceCall(rpctypes.ContextWithHeight(contextHeight), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResults []interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+	if len(decodedResults) != len(calls) {
+		return nil, fmt.Errorf("expected %d traces, got %d", len(calls), len(decodedResults))
+	}
+
+	// split the traces by bundle
+	results := make([][]interface{}, len(bundles))
+	for i, bundle := range bundles {
+		results[i] = decodedResults[:len(bundle.Transactions)]
+		decodedResults = decodedResults[len(bundle.Transactions):]
+	}
+
+	return results, nil
+}
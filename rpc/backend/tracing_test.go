@@ -0,0 +1,56 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestTraceCallManyLimits(t *testing.T) {
+	b := &Backend{}
+	latest := rpctypes.BlockNumberOrHash{BlockNumber: new(rpctypes.BlockNumber)}
+	call := evmtypes.TransactionArgs{}
+
+	testCases := []struct {
+		name     string
+		bundles  []rpctypes.Bundle
+		errorMsg string
+	}{
+		{
+			name:     "empty bundles",
+			bundles:  []rpctypes.Bundle{{}},
+			errorMsg: "empty bundles",
+		},
+		{
+			name:     "too many bundles",
+			bundles:  make([]rpctypes.Bundle, rpctypes.MaxTraceCallManyBundles+1),
+			errorMsg: "too many bundles",
+		},
+		{
+			name: "too many calls",
+			bundles: []rpctypes.Bundle{
+				{Transactions: make([]evmtypes.TransactionArgs, rpctypes.MaxTraceCallManyCalls)},
+				{Transactions: []evmtypes.TransactionArgs{call}},
+			},
+			errorMsg: "too many calls",
+		},
+		{
+			name: "block override",
+			bundles: []rpctypes.Bundle{
+				{Transactions: []evmtypes.TransactionArgs{call}},
+				{Transactions: []evmtypes.TransactionArgs{call}, BlockOverride: &rpctypes.BlockOverrides{}},
+			},
+			errorMsg: "bundle 1: block overrides are not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := b.TraceCallMany(tc.bundles, latest, nil)
+			require.ErrorContains(t, err, tc.errorMsg)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// msgIndexKey is the attribute added by the SDK to the events of a message
+// with the index of the message in the transaction.
+const msgIndexKey = "msg_index"
+
+var transferTraceConfig = &rpctypes.TraceConfig{
+	TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+}
+
+// transferFrame is a call frame of the callTracer output.
+type transferFrame struct {
+	Type  string          `json:"type"`
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Value *hexutil.Big    `json:"value"`
+	Error string          `json:"error"`
+	Calls []transferFrame `json:"calls"`
+}
+
+// GetBlockTransfers returns the transfers of native coins of the block at the
+// given height, in execution order:
+//   - the value transfers of the EVM transactions and of their internal calls,
+//     from the call traces of the block;
+//   - the bank transfers of the transactions, made by Cosmos messages such as
+//     MsgSend or by the precompiles.
+//
+// The bank transfers from and to the EVM module account, which settle the EVM
+// value transfers, and from and to the fee collector, which pay and refund the
+// fees, are not reported.
+//
+// If the block or some of its transactions can't be traced, the transfers
+// derived from the traces are missing: the other transfers are returned along
+// with the tracing error.
+func (b *Backend) GetBlockTransfers(height int64) ([]*rpctypes.Transfer, error) {
+	block, err := b.CometBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	blockRes, err := b.CometBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	var traceErrs []error
+	traces, err := b.TraceBlock(rpctypes.BlockNumber(height), transferTraceConfig, block)
+	if err != nil {
+		traceErrs = append(traceErrs, fmt.Errorf("failed to trace block %d: %w", height, err))
+	}
+
+	excluded := map[common.Address]bool{
+		common.BytesToAddress(authtypes.NewModuleAddress(evmtypes.ModuleName)):        true,
+		common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName)): true,
+	}
+	blockNumber := hexutil.Uint64(height) //nolint:gosec // G115 // heights are positive
+
+	transfers := []*rpctypes.Transfer{}
+	txDecoder := b.ClientCtx.TxConfig.TxDecoder()
+	for i, txBz := range block.Block.Txs {
+		if i >= len(blockRes.TxsResults) {
+			break
+		}
+		txResult := blockRes.TxsResults[i]
+		traced := rpctypes.TxSucessOrExpectedFailure(txResult)
+
+		// the hashes of the EVM transactions by message index, the hash of the
+		// Cosmos transaction identifying the other messages
+		cosmosHash := common.BytesToHash(txBz.Hash())
+		var ethHashes []common.Hash
+		if tx, err := txDecoder(txBz); err == nil {
+			for _, msg := range tx.GetMsgs() {
+				ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+				if !ok {
+					ethHashes = append(ethHashes, cosmosHash)
+					continue
+				}
+				ethHashes = append(ethHashes, ethMsg.Hash())
+
+				// the traces are the ones of the EVM transactions of the
+				// successful or expectedly failed Cosmos transactions
+				if !traced || len(traces) == 0 {
+					continue
+				}
+				var root transferFrame
+				switch {
+				case traces[0] == nil:
+				case traces[0].Error != "":
+					traceErrs = append(traceErrs, fmt.Errorf("failed to trace transaction %s: %s", ethMsg.Hash(), traces[0].Error))
+				default:
+					if bz, err := json.Marshal(traces[0].Result); err == nil {
+						_ = json.Unmarshal(bz, &root)
+					}
+				}
+				traces = traces[1:]
+				transfers = appendFrameTransfers(transfers, root, rpctypes.TransferSourceCall, blockNumber, ethMsg.Hash())
+			}
+		}
+
+		if txResult.Code != 0 {
+			continue
+		}
+		for _, event := range txResult.Events {
+			if event.Type != banktypes.EventTypeTransfer {
+				continue
+			}
+			txHash := cosmosHash
+			if index, err := strconv.Atoi(eventAttribute(event, msgIndexKey)); err == nil && index >= 0 && index < len(ethHashes) {
+				txHash = ethHashes[index]
+			}
+			transfers = append(transfers, bankTransfers(event, excluded, blockNumber, txHash)...)
+		}
+	}
+
+	return transfers, errors.Join(traceErrs...)
+}
+
+// appendFrameTransfers appends the value transfers of a call frame and of its
+// successful sub calls.
+func appendFrameTransfers(transfers []*rpctypes.Transfer, frame transferFrame, source string, blockNumber hexutil.Uint64, txHash common.Hash) []*rpctypes.Transfer {
+	if frame.Error != "" {
+		// reverted calls don't transfer
+		return transfers
+	}
+	if frame.Value != nil && frame.Value.ToInt().Sign() > 0 && frame.To != nil {
+		transfers = append(transfers, &rpctypes.Transfer{
+			BlockNumber: blockNumber,
+			From:        frame.From,
+			To:          *frame.To,
+			Value:       frame.Value,
+			Denom:       evmtypes.GetEVMCoinExtendedDenom(),
+			TxHash:      txHash,
+			Source:      source,
+		})
+	}
+	for _, call := range frame.Calls {
+		transfers = appendFrameTransfers(transfers, call, rpctypes.TransferSourceInternal, blockNumber, txHash)
+	}
+	return transfers
+}
+
+// bankTransfers returns the transfers of a bank transfer event, one per coin.
+func bankTransfers(event abci.Event, excluded map[common.Address]bool, blockNumber hexutil.Uint64, txHash common.Hash) []*rpctypes.Transfer {
+	sender, err := sdk.AccAddressFromBech32(eventAttribute(event, banktypes.AttributeKeySender))
+	if err != nil || len(sender) != common.AddressLength {
+		return nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(eventAttribute(event, banktypes.AttributeKeyRecipient))
+	if err != nil || len(recipient) != common.AddressLength {
+		return nil
+	}
+	from, to := common.BytesToAddress(sender), common.BytesToAddress(recipient)
+	if excluded[from] || excluded[to] {
+		return nil
+	}
+	coins, err := sdk.ParseCoinsNormalized(eventAttribute(event, sdk.AttributeKeyAmount))
+	if err != nil {
+		return nil
+	}
+
+	transfers := make([]*rpctypes.Transfer, 0, len(coins))
+	for _, coin := range coins {
+		transfers = append(transfers, &rpctypes.Transfer{
+			BlockNumber: blockNumber,
+			From:        from,
+			To:          to,
+			Value:       (*hexutil.Big)(coin.Amount.BigInt()),
+			Denom:       coin.Denom,
+			TxHash:      txHash,
+			Source:      rpctypes.TransferSourceBank,
+		})
+	}
+	return transfers
+}
+
+// eventAttribute returns the value of the attribute of the event, empty if
+// absent.
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
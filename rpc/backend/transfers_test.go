@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func transferEvent(from, to sdk.AccAddress, amount string, msgIndex string) abcitypes.Event {
+	event := abcitypes.Event{Type: banktypes.EventTypeTransfer, Attributes: []abcitypes.EventAttribute{
+		{Key: banktypes.AttributeKeyRecipient, Value: to.String()},
+		{Key: banktypes.AttributeKeySender, Value: from.String()},
+		{Key: sdk.AttributeKeyAmount, Value: amount},
+	}}
+	if msgIndex != "" {
+		event.Attributes = append(event.Attributes, abcitypes.EventAttribute{Key: msgIndexKey, Value: msgIndex})
+	}
+	return event
+}
+
+func TestGetBlockTransfers(t *testing.T) {
+	backend := setupMockBackend(t)
+	height := int64(5)
+
+	msg := buildMsgEthereumTx(t)
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	backend.ClientCtx = backend.ClientCtx.WithTxConfig(encodingConfig.TxConfig)
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	ethTxBz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	cosmosTxBz := tmtypes.Tx{0x1}
+
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	carol := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	evmModule := authtypes.NewModuleAddress(evmtypes.ModuleName)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	client := backend.ClientCtx.Client.(*mocks.Client)
+	client.On("Block", mock.Anything, &height).Return(&tmrpctypes.ResultBlock{
+		Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: height},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{ethTxBz, cosmosTxBz}},
+		},
+	}, nil)
+	client.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{
+		Height: height,
+		TxsResults: []*abcitypes.ExecTxResult{
+			{Events: []abcitypes.Event{
+				transferEvent(alice.Bytes(), feeCollector, "10aatom", ""),
+				transferEvent(evmModule, bob.Bytes(), "16aatom", "0"),
+				transferEvent(alice.Bytes(), carol.Bytes(), "7uosmo", "0"),
+			}},
+			{Events: []abcitypes.Event{
+				transferEvent(bob.Bytes(), carol.Bytes(), "3aatom,2uosmo", "0"),
+			}},
+		},
+	}, nil)
+	client.On("ConsensusParams", mock.Anything, mock.AnythingOfType("*int64")).
+		Return(&tmrpctypes.ResultConsensusParams{ConsensusParams: *tmtypes.DefaultConsensusParams()}, nil)
+
+	traces, err := json.Marshal([]*evmtypes.TxTraceResult{{Result: map[string]interface{}{
+		"type":  "CALL",
+		"from":  alice,
+		"to":    bob,
+		"value": "0x10",
+		"calls": []map[string]interface{}{
+			{"type": "CALL", "from": bob, "to": carol, "value": "0x5"},
+			{"type": "STATICCALL", "from": bob, "to": carol},
+			{
+				"type": "CALL", "from": bob, "to": alice, "value": "0x1", "error": "execution reverted",
+				"calls": []map[string]interface{}{{"type": "CALL", "from": alice, "to": carol, "value": "0x1"}},
+			},
+		},
+	}}})
+	require.NoError(t, err)
+	backend.QueryClient.QueryClient.(*mocks.EVMQueryClient).On("TraceBlock", mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryTraceBlockResponse{Data: traces}, nil)
+
+	transfers, err := backend.GetBlockTransfers(height)
+	require.NoError(t, err)
+
+	extendedDenom := evmtypes.GetEVMCoinExtendedDenom()
+	ethHash, cosmosHash := msg.Hash(), common.BytesToHash(cosmosTxBz.Hash())
+	expected := []*rpctypes.Transfer{
+		{From: alice, To: bob, Value: (*hexutil.Big)(big.NewInt(16)), Denom: extendedDenom, TxHash: ethHash, Source: rpctypes.TransferSourceCall},
+		{From: bob, To: carol, Value: (*hexutil.Big)(big.NewInt(5)), Denom: extendedDenom, TxHash: ethHash, Source: rpctypes.TransferSourceInternal},
+		{From: alice, To: carol, Value: (*hexutil.Big)(big.NewInt(7)), Denom: "uosmo", TxHash: ethHash, Source: rpctypes.TransferSourceBank},
+		{From: bob, To: carol, Value: (*hexutil.Big)(big.NewInt(3)), Denom: "aatom", TxHash: cosmosHash, Source: rpctypes.TransferSourceBank},
+		{From: bob, To: carol, Value: (*hexutil.Big)(big.NewInt(2)), Denom: "uosmo", TxHash: cosmosHash, Source: rpctypes.TransferSourceBank},
+	}
+	for _, transfer := range expected {
+		transfer.BlockNumber = hexutil.Uint64(height)
+	}
+	require.Equal(t, expected, transfers)
+
+	// the bank transfers are returned along with the tracing error
+	queryClient := backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+	queryClient.ExpectedCalls = nil
+	queryClient.On("TraceBlock", mock.Anything, mock.Anything).Return(nil, errors.New("tracing failed"))
+	transfers, err = backend.GetBlockTransfers(height)
+	require.ErrorContains(t, err, "failed to trace block 5")
+	require.ErrorContains(t, err, "tracing failed")
+	require.Equal(t, expected[2:], transfers)
+}
@@ -33,6 +33,9 @@ import (
 func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error) {
 	res, err := b.GetTxByEthHash(txHash)
 	if err != nil {
+		if tx, ok := b.getCosmosPseudoTx(txHash); ok {
+			return tx, nil
+		}
 		return b.GetTransactionByHashPending(txHash)
 	}
 
@@ -138,9 +141,29 @@ func (b *Backend) GetGasUsed(res *servertypes.TxResult, price *big.Int, gas uint
 
 // GetTransactionReceipt returns the transaction receipt identified by hash.
 func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	if receipt, ok := b.cache.receipt(hash); ok {
+		return receipt, nil
+	}
+
+	receipt, err := b.getTransactionReceipt(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cache.addReceipt(hash, receipt)
+	return receipt, nil
+}
+
+// getTransactionReceipt queries the receipt of the transaction identified by
+// hash, returning nil if it is not found.
+func (b *Backend) getTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
 	hexTx := hash.Hex()
 	b.Logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok, err := b.getCosmosPseudoReceipt(hash); ok || err != nil {
+		return receipt, err
+	}
+
 	// Retry logic for transaction lookup with exponential backoff
 	maxRetries := 10
 	baseDelay := 50 * time.Millisecond
@@ -312,6 +335,27 @@ func (b *Backend) GetTxByEthHash(hash common.Hash) (*servertypes.TxResult, error
 	return txResult, nil
 }
 
+// GetTxHashBySenderAndNonce returns the hash of the transaction sent by the
+// given address with the given nonce, or nil if not found. It requires the
+// EVM indexer.
+func (b *Backend) GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("the EVM indexer is disabled")
+	}
+	return b.Indexer.GetBySenderAndNonce(sender, nonce)
+}
+
+// SearchTxHashesByAddress returns the hashes of up to pageSize transactions
+// sent or received by the given address, in the blocks before (reverse) or
+// after the given block number, and whether more transactions remain. It
+// requires the EVM indexer.
+func (b *Backend) SearchTxHashesByAddress(address common.Address, blockNumber int64, reverse bool, pageSize int) ([]common.Hash, bool, error) {
+	if b.Indexer == nil {
+		return nil, false, errors.New("the EVM indexer is disabled")
+	}
+	return b.Indexer.GetByAddress(address, blockNumber, reverse, pageSize)
+}
+
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (b *Backend) GetTxByTxIndex(height int64, index uint) (*servertypes.TxResult, error) {
 	int32Index := int32(index) //#nosec G115 -- checked for int overflow already
@@ -386,6 +430,10 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *cmtrpctypes.ResultBlock,
 	} else {
 		i := int(idx) // #nosec G115
 		ethMsgs := b.EthMsgsFromCometBlock(block, blockRes)
+		if pseudoTxs := b.cosmosPseudoTxs(block, blockRes); i >= len(ethMsgs) && i < len(ethMsgs)+len(pseudoTxs) {
+			height := uint64(block.Block.Height) // #nosec G115 -- checked for int overflow already
+			return rpctypes.NewRPCCosmosPseudoTx(pseudoTxs[i-len(ethMsgs)], common.BytesToHash(block.BlockID.Hash), height, uint64(idx), b.EvmChainID), nil
+		}
 		if i >= len(ethMsgs) {
 			b.Logger.Debug("block txs index out of bound", "index", i)
 			return nil, nil
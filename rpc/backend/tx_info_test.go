@@ -467,6 +467,18 @@ func (m *MockIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ser
 	return nil, nil
 }
 
+func (m *MockIndexer) GetByAddress(address common.Address, blockNumber int64, reverse bool, limit int) ([]common.Hash, bool, error) {
+	return nil, false, nil
+}
+
+func (m *MockIndexer) GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	return nil, nil
+}
+
+func (m *MockIndexer) BlocksWithLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	return nil, false, nil
+}
+
 // Note: A3 (EthTxIndex=-1 in GetTransactionByHash) is already guarded at tx_info.go:82
 // and covered by TestReceiptsFromCometBlock_SentinelEthTxIndex as a regression test.
 
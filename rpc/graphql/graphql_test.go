@@ -0,0 +1,227 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// fakeBackend serves the blocks 0 to 10, each with a transaction emitting
+// a log.
+type fakeBackend struct {
+	backend.EVMBackend
+}
+
+var (
+	miner   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	emitter = common.HexToAddress("0x1000000000000000000000000000000000000002")
+)
+
+func txHash(number int64) common.Hash {
+	return common.BigToHash(big.NewInt(number + 100))
+}
+
+func (fakeBackend) BlockNumber() (hexutil.Uint64, error) {
+	return 10, nil
+}
+
+func (fakeBackend) RPCBlockRangeCap() int32 {
+	return 5
+}
+
+func (b fakeBackend) GetBlockByNumber(number rpctypes.BlockNumber, _ bool) (map[string]interface{}, error) {
+	if number < 0 {
+		number = 10
+	}
+	if number > 10 {
+		return nil, nil
+	}
+	tx, _ := b.GetTransactionByHash(txHash(int64(number)))
+	return map[string]interface{}{
+		"number":       (*hexutil.Big)(big.NewInt(int64(number))),
+		"hash":         hexutil.Bytes(common.BigToHash(big.NewInt(int64(number))).Bytes()),
+		"miner":        miner,
+		"gasLimit":     hexutil.Uint64(30_000_000),
+		"transactions": []interface{}{tx},
+	}, nil
+}
+
+func (fakeBackend) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	number := hash.Big().Int64() - 100
+	index := hexutil.Uint64(0)
+	return &rpctypes.RPCTransaction{
+		Hash:             hash,
+		From:             miner,
+		BlockHash:        &common.Hash{},
+		BlockNumber:      (*hexutil.Big)(big.NewInt(number)),
+		TransactionIndex: &index,
+		Value:            (*hexutil.Big)(big.NewInt(1)),
+	}, nil
+}
+
+func (fakeBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"status":  hexutil.Uint(1),
+		"gasUsed": hexutil.Uint64(21_000),
+		"logs":    []*ethtypes.Log{{Address: emitter, TxHash: hash}},
+	}, nil
+}
+
+func (fakeBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	return [][]*ethtypes.Log{{{Address: emitter, BlockNumber: uint64(*height), TxHash: txHash(*height)}}}, nil //nolint:gosec // G115 -- test heights are positive
+}
+
+func (fakeBackend) GetBalance(address common.Address, _ rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	return (*hexutil.Big)(new(big.Int).SetBytes(address.Bytes()[19:])), nil
+}
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	h, err := NewHandler(fakeBackend{}, log.NewNopLogger(), nil, nil)
+	require.NoError(t, err)
+	return h
+}
+
+func exec(t *testing.T, h *Handler, query string, variables map[string]interface{}) string {
+	t.Helper()
+	bz, err := json.Marshal(h.schema.Exec(context.Background(), query, "", variables))
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestQueries(t *testing.T) {
+	h := newTestHandler(t)
+
+	testCases := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		exp       string
+	}{
+		{
+			"latest block with nested objects",
+			`{ block { number gasLimit miner { address balance } transactions { index status gasUsed value logs { account { address } } } } }`,
+			nil,
+			`{"data":{"block":{"number":"0xa","gasLimit":"0x1c9c380","miner":{"address":"0x1000000000000000000000000000000000000001","balance":"0x1"},
+				"transactions":[{"index":"0x0","status":"0x1","gasUsed":"0x5208","value":"0x1","logs":[{"account":{"address":"0x1000000000000000000000000000000000000002"}}]}]}}}`,
+		},
+		{
+			"block number from a JSON variable",
+			`query($n: Long) { block(number: $n) { number parent { number } } }`,
+			map[string]interface{}{"n": float64(1)},
+			`{"data":{"block":{"number":"0x1","parent":{"number":"0x0"}}}}`,
+		},
+		{
+			"blocks are clamped to the latest block",
+			`{ blocks(from: 8, to: 20) { number } }`,
+			nil,
+			`{"data":{"blocks":[{"number":"0x8"},{"number":"0x9"},{"number":"0xa"}]}}`,
+		},
+		{
+			"block logs filtered by address",
+			`{ block(number: "0x2") { a: logs(filter: { addresses: ["0x1000000000000000000000000000000000000002"] }) { index } b: logs(filter: { addresses: ["0x1000000000000000000000000000000000000001"] }) { index } } }`,
+			nil,
+			`{"data":{"block":{"a":[{"index":"0x0"}],"b":[]}}}`,
+		},
+		{
+			"unsupported fields",
+			`{ block { raw } }`,
+			nil,
+			`{"errors":[{"message":"field not supported","path":["block","raw"]}],"data":{"block":null}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.JSONEq(t, tc.exp, exec(t, h, tc.query, tc.variables))
+		})
+	}
+
+	// the block range is capped
+	res := h.schema.Exec(context.Background(), `{ blocks(from: 0) { number } }`, "", nil)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, "block range greater than 5", res.Errors[0].Message)
+}
+
+func TestLimits(t *testing.T) {
+	h := newTestHandler(t)
+
+	// the selections nested deeper than the maximum depth are rejected
+	query := "{ block { number } }"
+	for range maxDepth / 2 {
+		query = strings.Replace(query, "number", "transactions { block { number } }", 1)
+	}
+	res := h.schema.Exec(context.Background(), query, "", nil)
+	require.NotEmpty(t, res.Errors)
+	require.Contains(t, res.Errors[0].Message, "exceeds max depth")
+
+	// the queries resolving more fields than the maximum fail
+	limited, err := graphql.ParseSchema(schema, &Resolver{backend: fakeBackend{}, logger: log.NewNopLogger()},
+		graphql.Tracer(fieldLimiter{max: 8}))
+	require.NoError(t, err)
+	res = limited.Exec(context.Background(), `{ blocks(from: 8) { number hash } }`, "", nil)
+	require.Empty(t, res.Errors)
+	res = limited.Exec(context.Background(), `{ blocks(from: 8) { number hash gasLimit } }`, "", nil)
+	require.Len(t, res.Errors, 1)
+	require.Contains(t, res.Errors[0].Message, "query exceeds the maximum of 8 fields")
+	require.Nil(t, res.Data)
+
+	// the request context is passed to the resolvers
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res = h.schema.Exec(ctx, `{ block { number } }`, "", nil)
+	require.NotEmpty(t, res.Errors)
+}
+
+func TestServeHTTP(t *testing.T) {
+	h := newTestHandler(t)
+
+	w := httptest.NewRecorder()
+	body := `{"query":"query($n: Long) { block(number: $n) { number } }","variables":{"n":3}}`
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"data":{"block":{"number":"0x3"}}}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/graphql", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestReadRequest(t *testing.T) {
+	get := httptest.NewRequest(http.MethodGet, `/graphql?query=%7Bblock%7Bnumber%7D%7D&variables=%7B%22n%22%3A1%7D`, nil)
+	req, err := readRequest(get)
+	require.NoError(t, err)
+	require.Equal(t, "{block{number}}", req.Query)
+	require.Equal(t, float64(1), req.Variables["n"])
+
+	post := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{block{number}}","operationName":"A","variables":{"n":"0x1"}}`))
+	req, err = readRequest(post)
+	require.NoError(t, err)
+	require.Equal(t, "{block{number}}", req.Query)
+	require.Equal(t, "A", req.OperationName)
+	require.Equal(t, "0x1", req.Variables["n"])
+
+	raw := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{block{number}}`))
+	raw.Header.Set("Content-Type", "application/graphql")
+	req, err = readRequest(raw)
+	require.NoError(t, err)
+	require.Equal(t, "{block{number}}", req.Query)
+
+	_, err = readRequest(httptest.NewRequest(http.MethodPut, "/graphql", nil))
+	require.Error(t, err)
+}
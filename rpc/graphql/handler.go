@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/ratelimit"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// Method is the JSON-RPC method name the GraphQL requests are authorized
+	// and rate limited as.
+	Method = "graphql_query"
+	// maxBodySize is the maximum size of the request body.
+	maxBodySize = 5 * 1024 * 1024
+	// maxDepth is the maximum nesting depth of the query selections.
+	maxDepth = 20
+	// maxFields is the maximum number of fields resolved by a query, counting
+	// every element of the lists.
+	maxFields = 10_000
+)
+
+// request is a GraphQL request.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves the EIP-1767 GraphQL queries over HTTP with the schema of
+// go-ethereum. Subscriptions are not supported.
+type Handler struct {
+	schema  *graphql.Schema
+	auth    *auth.Authenticator
+	limiter *ratelimit.Limiter
+}
+
+// NewHandler creates a GraphQL handler resolving the queries with the given
+// backend. The requests are authorized and rate limited as calls of the
+// graphql_query method.
+func NewHandler(evmBackend backend.EVMBackend, logger log.Logger, authenticator *auth.Authenticator, limiter *ratelimit.Limiter) (*Handler, error) {
+	resolver := &Resolver{backend: evmBackend, logger: logger}
+	parsed, err := graphql.ParseSchema(schema, resolver,
+		graphql.MaxDepth(maxDepth),
+		graphql.Tracer(fieldLimiter{max: maxFields}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{
+		schema:  parsed,
+		auth:    authenticator,
+		limiter: limiter,
+	}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	perms, err := h.auth.Authenticate(r)
+	if err != nil {
+		writeResponse(w, http.StatusUnauthorized, errorResponse(err.Error()))
+		return
+	}
+	if !perms.Allowed(Method) {
+		writeResponse(w, http.StatusForbidden, errorResponse("GraphQL queries are not allowed"))
+		return
+	}
+	if !h.limiter.Allow(h.limiter.ClientID(perms, r), []auth.Request{{Method: Method}}) {
+		writeResponse(w, http.StatusTooManyRequests, errorResponse(ratelimit.ErrMsgLimitExceeded))
+		return
+	}
+
+	req, err := readRequest(r)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, errorResponse(err.Error()))
+		return
+	}
+
+	writeResponse(w, http.StatusOK, h.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables))
+}
+
+// readRequest reads a GraphQL request from the query parameters of a GET
+// request, or from the JSON or application/graphql body of a POST request.
+func readRequest(r *http.Request) (request, error) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if variables := params.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return req, err
+			}
+		}
+	case http.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+		if err != nil {
+			return req, err
+		}
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/graphql") {
+			req.Query = string(body)
+		} else if err := json.Unmarshal(body, &req); err != nil {
+			return req, err
+		}
+	default:
+		return req, errors.New("only GET and POST requests are supported")
+	}
+	return req, nil
+}
+
+func errorResponse(msg string) *graphql.Response {
+	return &graphql.Response{Errors: []*gqlerrors.QueryError{{Message: msg}}}
+}
+
+func writeResponse(w http.ResponseWriter, status int, res *graphql.Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+)
+
+// fieldLimiter is a tracer bounding the complexity of the queries by the
+// number of fields they resolve. Once a query exceeds the maximum, its context
+// reports an error, stopping the execution of the remaining resolvers and
+// failing the query.
+type fieldLimiter struct {
+	max int64
+}
+
+var _ trace.Tracer = fieldLimiter{}
+
+// queryContextKey is the context key of the queryContext.
+type queryContextKey struct{}
+
+// queryContext is the context of a query, counting its resolved fields.
+type queryContext struct {
+	context.Context
+	fields   atomic.Int64
+	exceeded atomic.Bool
+	max      int64
+}
+
+// Err returns an error once the query exceeded the maximum number of fields.
+func (c *queryContext) Err() error {
+	if c.exceeded.Load() {
+		return fmt.Errorf("query exceeds the maximum of %d fields", c.max)
+	}
+	return c.Context.Err()
+}
+
+func (c *queryContext) Value(key interface{}) interface{} {
+	if key == (queryContextKey{}) {
+		return c
+	}
+	return c.Context.Value(key)
+}
+
+// TraceQuery implements trace.Tracer.
+func (l fieldLimiter) TraceQuery(
+	ctx context.Context, _, _ string, _ map[string]interface{}, _ map[string]*introspection.Type,
+) (context.Context, trace.TraceQueryFinishFunc) {
+	return &queryContext{Context: ctx, max: l.max}, func([]*gqlerrors.QueryError) {}
+}
+
+// TraceField implements trace.Tracer.
+func (l fieldLimiter) TraceField(
+	ctx context.Context, _, _, _ string, _ bool, _ map[string]interface{},
+) (context.Context, trace.TraceFieldFinishFunc) {
+	if query, ok := ctx.Value(queryContextKey{}).(*queryContext); ok && query.fields.Add(1) > l.max {
+		query.exceeded.Store(true)
+	}
+	return ctx, func(*gqlerrors.QueryError) {}
+}
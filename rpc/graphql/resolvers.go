@@ -0,0 +1,837 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// errNotSupported is returned for the fields of the schema that cannot be
+// resolved from the CometBFT blocks.
+var errNotSupported = errors.New("field not supported")
+
+// Long is a 64 bit integer, accepting the decimal and hex strings and the
+// numbers of the query and of the JSON variables.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) //nolint:gosec // G115 -- block numbers and indexes fit in int64
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// BlockNumberArgs are the arguments of the account fields, optionally
+// overriding the block of the account state.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// number returns the block number of the arguments, or the given default.
+func (a BlockNumberArgs) number(number rpctypes.BlockNumber) rpctypes.BlockNumber {
+	if a.Block != nil {
+		return rpctypes.BlockNumber(*a.Block)
+	}
+	return number
+}
+
+// CallArgs are the arguments of the call and estimateGas fields.
+type CallArgs struct {
+	Data evmtypes.TransactionArgs
+}
+
+// BlockFilterCriteria are the log filter criteria within a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// FilterCriteria are the log filter criteria over a block range, by default
+// the latest block.
+type FilterCriteria struct {
+	FromBlock *Long
+	ToBlock   *Long
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// filterValues returns the addresses and topics of the filter criteria.
+func filterValues(addresses *[]common.Address, topics *[][]common.Hash) ([]common.Address, [][]common.Hash) {
+	var (
+		addrs []common.Address
+		tops  [][]common.Hash
+	)
+	if addresses != nil {
+		addrs = *addresses
+	}
+	if topics != nil {
+		tops = *topics
+	}
+	return addrs, tops
+}
+
+// Resolver is the root resolver of the Query and Mutation types, resolving
+// the fields with the JSON-RPC backend.
+type Resolver struct {
+	backend backend.EVMBackend
+	logger  log.Logger
+}
+
+// Block returns the block with the given hash or number, by default the
+// latest block.
+func (r *Resolver) Block(args struct {
+	Number *Long
+	Hash   *common.Hash
+},
+) (*Block, error) {
+	if args.Hash != nil {
+		return r.blockByHash(*args.Hash)
+	}
+	number := rpctypes.EthLatestBlockNumber
+	if args.Number != nil {
+		number = rpctypes.BlockNumber(*args.Number)
+	}
+	return r.blockByNumber(number)
+}
+
+// Blocks returns the blocks in the given range, up to the latest block and to
+// the block range cap.
+func (r *Resolver) Blocks(args struct {
+	From *Long
+	To   *Long
+},
+) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	latest, err := r.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from := int64(*args.From)
+	to := int64(latest) //nolint:gosec // G115 -- block numbers fit in int64
+	if args.To != nil {
+		to = min(int64(*args.To), to)
+	}
+	if to < from {
+		return []*Block{}, nil
+	}
+	if limit := int64(r.backend.RPCBlockRangeCap()); limit > 0 && to-from+1 > limit {
+		return nil, fmt.Errorf("block range greater than %d", limit)
+	}
+
+	blocks := make([]*Block, 0, to-from+1)
+	for n := from; n <= to; n++ {
+		block, err := r.blockByNumber(rpctypes.BlockNumber(n))
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// Pending returns the pending state of the mempool.
+func (r *Resolver) Pending() *Pending {
+	return &Pending{r: r}
+}
+
+// Transaction returns the transaction with the given hash.
+func (r *Resolver) Transaction(args struct{ Hash common.Hash }) (*Transaction, error) {
+	return r.transactionByHash(args.Hash)
+}
+
+// Logs returns the logs matching the filter criteria.
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	latest, err := r.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := int64(latest), int64(latest) //nolint:gosec // G115 -- block numbers fit in int64
+	if args.Filter.FromBlock != nil {
+		from = int64(*args.Filter.FromBlock)
+	}
+	if args.Filter.ToBlock != nil {
+		to = int64(*args.Filter.ToBlock)
+	}
+	addresses, topics := filterValues(args.Filter.Addresses, args.Filter.Topics)
+
+	logs, err := filters.NewRangeFilter(r.logger, r.backend, from, to, addresses, topics).
+		Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	return r.newLogs(logs), nil
+}
+
+// GasPrice returns the suggested gas price.
+func (r *Resolver) GasPrice() (hexutil.Big, error) {
+	price, err := r.backend.GasPrice()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigValue(price), nil
+}
+
+// MaxPriorityFeePerGas returns the suggested gas tip cap.
+func (r *Resolver) MaxPriorityFeePerGas() (hexutil.Big, error) {
+	head, err := r.backend.CurrentHeader()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tip, err := r.backend.SuggestGasTipCap(head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tip), nil
+}
+
+// Syncing returns the sync progress of the node, nil once synced.
+func (r *Resolver) Syncing() (*SyncState, error) {
+	status, err := r.backend.Syncing()
+	if err != nil {
+		return nil, err
+	}
+	progress, ok := status.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	return &SyncState{progress: progress}, nil
+}
+
+// ChainID returns the EIP-155 chain ID.
+func (r *Resolver) ChainID() (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigValue(chainID), nil
+}
+
+// SendRawTransaction broadcasts a signed transaction.
+func (r *Resolver) SendRawTransaction(args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(args.Data)
+}
+
+// blockByNumber returns the block with the given number, nil if not found.
+func (r *Resolver) blockByNumber(number rpctypes.BlockNumber) (*Block, error) {
+	fields, err := r.backend.GetBlockByNumber(number, true)
+	if err != nil || fields == nil {
+		return nil, err
+	}
+	return &Block{r: r, fields: fields}, nil
+}
+
+// blockByHash returns the block with the given hash, nil if not found.
+func (r *Resolver) blockByHash(hash common.Hash) (*Block, error) {
+	fields, err := r.backend.GetBlockByHash(hash, true)
+	if err != nil || fields == nil {
+		return nil, err
+	}
+	return &Block{r: r, fields: fields}, nil
+}
+
+// transactionByHash returns the transaction with the given hash, nil if not
+// found.
+func (r *Resolver) transactionByHash(hash common.Hash) (*Transaction, error) {
+	tx, err := r.backend.GetTransactionByHash(hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &Transaction{r: r, tx: tx}, nil
+}
+
+func (r *Resolver) newLogs(logs []*ethtypes.Log) []*Log {
+	res := make([]*Log, len(logs))
+	for i, l := range logs {
+		res[i] = &Log{r: r, log: l}
+	}
+	return res
+}
+
+func (r *Resolver) account(address common.Address, number rpctypes.BlockNumber) *Account {
+	return &Account{r: r, address: address, number: number}
+}
+
+// call executes a message call at the given block.
+func (r *Resolver) call(args evmtypes.TransactionArgs, number rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(args, number, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CallResult{res: res}, nil
+}
+
+// estimateGas estimates the gas of a message call at the given block.
+func (r *Resolver) estimateGas(args evmtypes.TransactionArgs, number rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	return r.backend.EstimateGas(args, &rpctypes.BlockNumberOrHash{BlockNumber: &number}, nil)
+}
+
+// Block is a mined block, resolved from its JSON-RPC representation with the
+// full transactions.
+type Block struct {
+	r      *Resolver
+	fields map[string]interface{}
+}
+
+func (b *Block) number() rpctypes.BlockNumber {
+	number, _ := b.fields["number"].(*hexutil.Big)
+	return rpctypes.BlockNumber(number.ToInt().Int64())
+}
+
+func (b *Block) hash(name string) common.Hash {
+	hash, _ := b.fields[name].(common.Hash)
+	return hash
+}
+
+func (b *Block) uint64(name string) hexutil.Uint64 {
+	n, _ := b.fields[name].(hexutil.Uint64)
+	return n
+}
+
+func (b *Block) transactions() []*Transaction {
+	txs, _ := b.fields["transactions"].([]interface{})
+	res := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if rpcTx, ok := tx.(*rpctypes.RPCTransaction); ok && rpcTx != nil {
+			res = append(res, &Transaction{r: b.r, tx: rpcTx})
+		}
+	}
+	return res
+}
+
+func (b *Block) Number() hexutil.Uint64 {
+	return hexutil.Uint64(b.number()) //nolint:gosec // G115 -- block numbers are not negative
+}
+
+func (b *Block) Hash() common.Hash {
+	hash, _ := b.fields["hash"].(hexutil.Bytes)
+	return common.BytesToHash(hash)
+}
+
+func (b *Block) Parent() (*Block, error) {
+	if b.number() == 0 {
+		return nil, nil
+	}
+	return b.r.blockByNumber(b.number() - 1)
+}
+
+func (b *Block) Nonce() hexutil.Bytes {
+	nonce, _ := b.fields["nonce"].(ethtypes.BlockNonce)
+	return nonce[:]
+}
+
+func (b *Block) TransactionsRoot() common.Hash { return b.hash("transactionsRoot") }
+
+func (b *Block) TransactionCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(len(b.transactions()))
+	return &count
+}
+
+func (b *Block) StateRoot() common.Hash { return b.hash("stateRoot") }
+
+func (b *Block) ReceiptsRoot() common.Hash { return b.hash("receiptsRoot") }
+
+func (b *Block) Miner(args BlockNumberArgs) *Account {
+	miner, _ := b.fields["miner"].(common.Address)
+	return b.r.account(miner, args.number(b.number()))
+}
+
+func (b *Block) ExtraData() hexutil.Bytes {
+	extra, _ := b.fields["extraData"].(hexutil.Bytes)
+	return extra
+}
+
+func (b *Block) GasLimit() hexutil.Uint64 { return b.uint64("gasLimit") }
+
+func (b *Block) GasUsed() hexutil.Uint64 { return b.uint64("gasUsed") }
+
+func (b *Block) BaseFeePerGas() *hexutil.Big {
+	baseFee, _ := b.fields["baseFeePerGas"].(*hexutil.Big)
+	return baseFee
+}
+
+func (b *Block) NextBaseFeePerGas() (*hexutil.Big, error) { return nil, errNotSupported }
+
+func (b *Block) Timestamp() hexutil.Uint64 { return b.uint64("timestamp") }
+
+func (b *Block) LogsBloom() hexutil.Bytes {
+	bloom, _ := b.fields["logsBloom"].(ethtypes.Bloom)
+	return bloom.Bytes()
+}
+
+func (b *Block) MixHash() common.Hash { return b.hash("mixHash") }
+
+func (b *Block) Difficulty() hexutil.Big {
+	difficulty, _ := b.fields["difficulty"].(*hexutil.Big)
+	if difficulty == nil {
+		return hexutil.Big{}
+	}
+	return *difficulty
+}
+
+// OmmerCount returns zero, the CometBFT blocks have no ommers.
+func (b *Block) OmmerCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(0)
+	return &count
+}
+
+func (b *Block) Ommers() *[]*Block { return &[]*Block{} }
+
+func (b *Block) OmmerAt(struct{ Index Long }) *Block { return nil }
+
+func (b *Block) OmmerHash() common.Hash { return b.hash("sha3Uncles") }
+
+func (b *Block) Transactions() *[]*Transaction {
+	txs := b.transactions()
+	return &txs
+}
+
+func (b *Block) TransactionAt(args struct{ Index Long }) *Transaction {
+	txs := b.transactions()
+	if args.Index < 0 || int64(args.Index) >= int64(len(txs)) {
+		return nil
+	}
+	return txs[args.Index]
+}
+
+// Logs returns the logs of the block matching the filter criteria.
+func (b *Block) Logs(args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	height := b.number().Int64()
+	blockLogs, err := b.r.backend.GetLogsByHeight(&height)
+	if err != nil {
+		return nil, err
+	}
+	var logs []*ethtypes.Log
+	for _, txLogs := range blockLogs {
+		logs = append(logs, txLogs...)
+	}
+	addresses, topics := filterValues(args.Filter.Addresses, args.Filter.Topics)
+	return b.r.newLogs(filters.FilterLogs(logs, nil, nil, addresses, topics)), nil
+}
+
+func (b *Block) Account(args struct{ Address common.Address }) *Account {
+	return b.r.account(args.Address, b.number())
+}
+
+func (b *Block) Call(args CallArgs) (*CallResult, error) {
+	return b.r.call(args.Data, b.number())
+}
+
+func (b *Block) EstimateGas(args CallArgs) (hexutil.Uint64, error) {
+	return b.r.estimateGas(args.Data, b.number())
+}
+
+func (b *Block) RawHeader() (hexutil.Bytes, error) { return nil, errNotSupported }
+
+func (b *Block) Raw() (hexutil.Bytes, error) { return nil, errNotSupported }
+
+func (b *Block) WithdrawalsRoot() *common.Hash {
+	root, _ := b.fields["withdrawalsRoot"].(*common.Hash)
+	return root
+}
+
+func (b *Block) Withdrawals() (*[]*Withdrawal, error) { return nil, errNotSupported }
+
+func (b *Block) BlobGasUsed() (*hexutil.Uint64, error) { return nil, errNotSupported }
+
+func (b *Block) ExcessBlobGas() (*hexutil.Uint64, error) { return nil, errNotSupported }
+
+// Withdrawal is a validator withdrawal of the beacon chain, never returned
+// for the CometBFT blocks.
+type Withdrawal struct {
+	withdrawal *ethtypes.Withdrawal
+}
+
+func (w *Withdrawal) Index() hexutil.Uint64 { return hexutil.Uint64(w.withdrawal.Index) }
+
+func (w *Withdrawal) Validator() hexutil.Uint64 { return hexutil.Uint64(w.withdrawal.Validator) }
+
+func (w *Withdrawal) Address() common.Address { return w.withdrawal.Address }
+
+func (w *Withdrawal) Amount() hexutil.Uint64 { return hexutil.Uint64(w.withdrawal.Amount) }
+
+// Pending is the pending state of the mempool.
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) transactions() ([]*Transaction, error) {
+	pendingTxs, err := p.r.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	chainConfig := evmtypes.GetEthChainConfig()
+	txs := []*Transaction{}
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			rpcTx := rpctypes.NewRPCPendingTransaction(ethMsg.AsTransaction(), nil, chainConfig)
+			txs = append(txs, &Transaction{r: p.r, tx: rpcTx})
+		}
+	}
+	return txs, nil
+}
+
+func (p *Pending) TransactionCount() (hexutil.Uint64, error) {
+	txs, err := p.transactions()
+	return hexutil.Uint64(len(txs)), err
+}
+
+func (p *Pending) Transactions() (*[]*Transaction, error) {
+	txs, err := p.transactions()
+	if err != nil {
+		return nil, err
+	}
+	return &txs, nil
+}
+
+func (p *Pending) Account(args struct{ Address common.Address }) *Account {
+	return p.r.account(args.Address, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) Call(args CallArgs) (*CallResult, error) {
+	return p.r.call(args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(args CallArgs) (hexutil.Uint64, error) {
+	return p.r.estimateGas(args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+// Transaction is a mined or pending transaction. Its receipt is fetched once,
+// by the first of the receipt fields.
+type Transaction struct {
+	r  *Resolver
+	tx *rpctypes.RPCTransaction
+
+	mu      sync.Mutex
+	receipt map[string]interface{}
+}
+
+// getReceipt returns the receipt of the transaction, nil if it is pending.
+func (t *Transaction) getReceipt() (map[string]interface{}, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.receipt != nil || t.tx.BlockHash == nil {
+		return t.receipt, nil
+	}
+	receipt, err := t.r.backend.GetTransactionReceipt(t.tx.Hash)
+	if err != nil {
+		return nil, err
+	}
+	t.receipt = receipt
+	return receipt, nil
+}
+
+// receiptUint64 returns a Long field of the receipt, nil if the transaction
+// is pending.
+func (t *Transaction) receiptUint64(name string) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	n, ok := receipt[name].(hexutil.Uint64)
+	if !ok {
+		return nil, nil
+	}
+	return &n, nil
+}
+
+// blockNumber returns the number of the block of the transaction, or the
+// pending block.
+func (t *Transaction) blockNumber() rpctypes.BlockNumber {
+	if t.tx.BlockNumber == nil {
+		return rpctypes.EthPendingBlockNumber
+	}
+	return rpctypes.BlockNumber(t.tx.BlockNumber.ToInt().Int64())
+}
+
+func (t *Transaction) Hash() common.Hash { return t.tx.Hash }
+
+func (t *Transaction) Nonce() hexutil.Uint64 { return t.tx.Nonce }
+
+func (t *Transaction) Index() *hexutil.Uint64 { return t.tx.TransactionIndex }
+
+func (t *Transaction) From(args BlockNumberArgs) *Account {
+	return t.r.account(t.tx.From, args.number(t.blockNumber()))
+}
+
+func (t *Transaction) To(args BlockNumberArgs) *Account {
+	if t.tx.To == nil {
+		return nil
+	}
+	return t.r.account(*t.tx.To, args.number(t.blockNumber()))
+}
+
+func (t *Transaction) Value() hexutil.Big { return bigValue(t.tx.Value) }
+
+func (t *Transaction) GasPrice() hexutil.Big { return bigValue(t.tx.GasPrice) }
+
+func (t *Transaction) MaxFeePerGas() *hexutil.Big { return t.tx.GasFeeCap }
+
+func (t *Transaction) MaxPriorityFeePerGas() *hexutil.Big { return t.tx.GasTipCap }
+
+func (t *Transaction) MaxFeePerBlobGas() (*hexutil.Big, error) { return nil, errNotSupported }
+
+func (t *Transaction) EffectiveTip() (*hexutil.Big, error) { return nil, errNotSupported }
+
+func (t *Transaction) Gas() hexutil.Uint64 { return t.tx.Gas }
+
+func (t *Transaction) InputData() hexutil.Bytes { return t.tx.Input }
+
+func (t *Transaction) Block() (*Block, error) {
+	if t.tx.BlockHash == nil {
+		return nil, nil
+	}
+	return t.r.blockByHash(*t.tx.BlockHash)
+}
+
+func (t *Transaction) Status() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status, ok := receipt["status"].(hexutil.Uint)
+	if !ok {
+		return nil, nil
+	}
+	n := hexutil.Uint64(status)
+	return &n, nil
+}
+
+func (t *Transaction) GasUsed() (*hexutil.Uint64, error) { return t.receiptUint64("gasUsed") }
+
+func (t *Transaction) CumulativeGasUsed() (*hexutil.Uint64, error) {
+	return t.receiptUint64("cumulativeGasUsed")
+}
+
+func (t *Transaction) EffectiveGasPrice() (*hexutil.Big, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	price, _ := receipt["effectiveGasPrice"].(*hexutil.Big)
+	return price, nil
+}
+
+func (t *Transaction) BlobGasUsed() (*hexutil.Uint64, error) { return nil, errNotSupported }
+
+func (t *Transaction) BlobGasPrice() (*hexutil.Big, error) { return nil, errNotSupported }
+
+func (t *Transaction) CreatedContract(args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	contract, ok := receipt["contractAddress"].(common.Address)
+	if !ok {
+		return nil, nil
+	}
+	return t.r.account(contract, args.number(t.blockNumber())), nil
+}
+
+func (t *Transaction) Logs() (*[]*Log, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ethLogs, _ := receipt["logs"].([]*ethtypes.Log)
+	logs := t.r.newLogs(ethLogs)
+	return &logs, nil
+}
+
+func (t *Transaction) R() hexutil.Big { return bigValue(t.tx.R) }
+
+func (t *Transaction) S() hexutil.Big { return bigValue(t.tx.S) }
+
+func (t *Transaction) V() hexutil.Big { return bigValue(t.tx.V) }
+
+func (t *Transaction) YParity() *hexutil.Big {
+	if t.tx.YParity == nil {
+		return nil
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(uint64(*t.tx.YParity)))
+}
+
+func (t *Transaction) Type() *hexutil.Uint64 {
+	txType := t.tx.Type
+	return &txType
+}
+
+func (t *Transaction) AccessList() *[]*AccessTuple {
+	if t.tx.Accesses == nil {
+		return nil
+	}
+	tuples := make([]*AccessTuple, len(*t.tx.Accesses))
+	for i, tuple := range *t.tx.Accesses {
+		tuples[i] = &AccessTuple{tuple: tuple}
+	}
+	return &tuples
+}
+
+func (t *Transaction) Raw() (hexutil.Bytes, error) { return nil, errNotSupported }
+
+func (t *Transaction) RawReceipt() (hexutil.Bytes, error) { return nil, errNotSupported }
+
+func (t *Transaction) BlobVersionedHashes() (*[]common.Hash, error) { return nil, errNotSupported }
+
+// bigValue returns the value of a non-null BigInt field, zero if unset.
+func bigValue(n *hexutil.Big) hexutil.Big {
+	if n == nil {
+		return hexutil.Big{}
+	}
+	return *n
+}
+
+// AccessTuple is an entry of a transaction access list.
+type AccessTuple struct {
+	tuple ethtypes.AccessTuple
+}
+
+func (a *AccessTuple) Address() common.Address { return a.tuple.Address }
+
+func (a *AccessTuple) StorageKeys() []common.Hash { return a.tuple.StorageKeys }
+
+// Log is a log emitted by a transaction.
+type Log struct {
+	r   *Resolver
+	log *ethtypes.Log
+}
+
+func (l *Log) Index() hexutil.Uint64 { return hexutil.Uint64(l.log.Index) }
+
+func (l *Log) Account(args BlockNumberArgs) *Account {
+	number := rpctypes.BlockNumber(l.log.BlockNumber) //nolint:gosec // G115 -- block numbers fit in int64
+	return l.r.account(l.log.Address, args.number(number))
+}
+
+func (l *Log) Topics() []common.Hash {
+	if l.log.Topics == nil {
+		return []common.Hash{}
+	}
+	return l.log.Topics
+}
+
+func (l *Log) Data() hexutil.Bytes { return l.log.Data }
+
+func (l *Log) Transaction() (*Transaction, error) {
+	return l.r.transactionByHash(l.log.TxHash)
+}
+
+// Account is the state of an account at a block.
+type Account struct {
+	r       *Resolver
+	address common.Address
+	number  rpctypes.BlockNumber
+}
+
+func (a *Account) blockNrOrHash() rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &a.number}
+}
+
+func (a *Account) Address() common.Address { return a.address }
+
+func (a *Account) Balance() (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(a.address, a.blockNrOrHash())
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigValue(balance), nil
+}
+
+func (a *Account) TransactionCount() (hexutil.Uint64, error) {
+	nonce, err := a.r.backend.GetTransactionCount(a.address, a.number)
+	if err != nil || nonce == nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code() (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(a.address, a.blockNrOrHash())
+}
+
+func (a *Account) Storage(args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(a.address, args.Slot.Hex(), a.blockNrOrHash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// CallResult is the result of a message call.
+type CallResult struct {
+	res *evmtypes.MsgEthereumTxResponse
+}
+
+func (c *CallResult) Data() hexutil.Bytes { return c.res.Ret }
+
+func (c *CallResult) GasUsed() hexutil.Uint64 { return hexutil.Uint64(c.res.GasUsed) }
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	if c.res.Failed() {
+		return 0
+	}
+	return 1
+}
+
+// SyncState is the sync progress of the node.
+type SyncState struct {
+	progress map[string]interface{}
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	n, _ := s.progress["startingBlock"].(hexutil.Uint64)
+	return n
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	n, _ := s.progress["currentBlock"].(hexutil.Uint64)
+	return n
+}
+
+// HighestBlock returns the current block, the highest block is not known by
+// CometBFT.
+func (s *SyncState) HighestBlock() hexutil.Uint64 { return s.CurrentBlock() }
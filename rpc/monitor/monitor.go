@@ -0,0 +1,309 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hashicorp/go-metrics"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/server/config"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// maxInspectedSize is the size of the beginning of the response kept to
+	// read the JSON-RPC errors. The error responses being small, the larger
+	// responses are results.
+	maxInspectedSize = 64 * 1024
+
+	// unknownMethod labels the calls to the methods which aren't served, to
+	// bound the number of label values.
+	unknownMethod = "unknown"
+
+	labelNamespace = "namespace"
+	labelMethod    = "method"
+	labelQuery     = "query"
+)
+
+var (
+	keyRequests     = []string{"json_rpc", "requests"}
+	keyErrors       = []string{"json_rpc", "errors"}
+	keyDuration     = []string{"json_rpc", "duration"}
+	keyRequestSize  = []string{"json_rpc", "request_size"}
+	keyResponseSize = []string{"json_rpc", "response_size"}
+
+	keyQueryDuration = []string{"json_rpc", "backend", "duration"}
+	keyQueryErrors   = []string{"json_rpc", "backend", "errors"}
+)
+
+// SlowRequestFunc logs a request taking at least the slow request threshold,
+// with the hash of its parameters.
+type SlowRequestFunc func(method, paramsHash string, duration time.Duration)
+
+// response is a JSON-RPC response of a single or batch response body.
+type response struct {
+	ID    json.RawMessage `json:"id"`
+	Error *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// rawResponse is a response with the size of its JSON encoding.
+type rawResponse struct {
+	response
+	size int
+}
+
+// Monitor records the per method metrics of the JSON-RPC requests, labeled
+// by namespace and method, and logs the slow requests.
+//
+// The metrics are emitted to the telemetry of the node, exported to
+// Prometheus when enabled:
+//   - json_rpc_requests and json_rpc_errors count the requests and the
+//     requests answered with an error;
+//   - json_rpc_duration is the duration of the requests, in milliseconds;
+//   - json_rpc_request_size and json_rpc_response_size are the sizes of the
+//     requests and responses, in bytes;
+//   - json_rpc_backend_duration and json_rpc_backend_errors are the duration
+//     and the failures of the queries of the backend to the app, labeled by
+//     gRPC query, see QueryConn.
+//
+// The requests of a batch are recorded with the duration of the batch, and
+// the requests rejected before being served, such as the unauthorized or rate
+// limited ones, are recorded as errors.
+type Monitor struct {
+	slowThreshold time.Duration
+	slowRequest   SlowRequestFunc
+	// methods are the names of the served methods
+	methods map[string]struct{}
+}
+
+// NewMonitor creates a Monitor from the JSON-RPC configuration, labeling the
+// requests by the methods of the given APIs and logging the slow requests
+// with the given function. It returns nil if neither the telemetry nor the
+// slow request log is enabled.
+func NewMonitor(cfg config.JSONRPCConfig, apis []rpc.API, slowRequest SlowRequestFunc) *Monitor {
+	if !telemetry.IsTelemetryEnabled() && (cfg.SlowRequestThreshold <= 0 || slowRequest == nil) {
+		return nil
+	}
+	if slowRequest == nil {
+		slowRequest = func(string, string, time.Duration) {}
+	}
+
+	return &Monitor{
+		slowThreshold: cfg.SlowRequestThreshold,
+		slowRequest:   slowRequest,
+		methods:       methodNames(apis),
+	}
+}
+
+// methodNames returns the names of the methods served for the APIs, named
+// like the go-ethereum RPC server does: the namespace and the method with a
+// lower case first letter. The subscriptions, served by the WebSocket server,
+// are included.
+func methodNames(apis []rpc.API) map[string]struct{} {
+	names := map[string]struct{}{
+		"eth_subscribe":   {},
+		"eth_unsubscribe": {},
+	}
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := []rune(typ.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			names[api.Namespace+"_"+string(name)] = struct{}{}
+		}
+	}
+	return names
+}
+
+// Enabled reports whether the requests are monitored.
+func (m *Monitor) Enabled() bool {
+	return m != nil
+}
+
+// Handler returns an HTTP handler recording the requests passed to the given
+// handler and their responses. It must be the outermost handler, the body
+// being read and parsed once and shared with the inner handlers, see
+// auth.ReadBody.
+func (m *Monitor) Handler(next http.Handler) http.Handler {
+	if !m.Enabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		body, r := auth.ReadBody(r)
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		m.observe(body.Requests, len(body.Raw), rec.status, rec.inspected, rec.size, start)
+	})
+}
+
+// Record records a request of the given size served outside of the HTTP
+// handler, such as the subscriptions of the WebSocket server.
+func (m *Monitor) Record(method string, size int, start time.Time, failed bool) {
+	if !m.Enabled() {
+		return
+	}
+	m.record(method, nil, time.Since(start), failed, size, 0)
+}
+
+// Reject records the requests of a message of the given size rejected before
+// being served outside of the HTTP handler, such as the unauthorized or rate
+// limited messages of the WebSocket server. The requests are nil if the
+// message cannot be parsed, see auth.ParseRequests.
+func (m *Monitor) Reject(reqs []auth.Request, size int, start time.Time) {
+	if !m.Enabled() {
+		return
+	}
+	m.observe(reqs, size, http.StatusForbidden, nil, 0, start)
+}
+
+// observe records the requests of a single or batch request body of the
+// given size from the status and the size of the response, and from its
+// JSON-RPC errors when the response is fully inspected. The body is recorded
+// as a failed request of an unknown method if it holds no request.
+func (m *Monitor) observe(reqs []auth.Request, size, status int, inspected []byte, resSize int, start time.Time) {
+	duration := time.Since(start)
+	rejected := status >= http.StatusBadRequest
+	if len(reqs) == 0 {
+		m.record(unknownMethod, nil, duration, true, size, resSize)
+		return
+	}
+
+	responses := make(map[string]rawResponse)
+	if len(inspected) == resSize {
+		for _, raw := range parseBatch(inspected) {
+			var res response
+			if err := json.Unmarshal(raw, &res); err == nil {
+				responses[string(res.ID)] = rawResponse{response: res, size: len(raw)}
+			}
+		}
+	}
+
+	for _, req := range reqs {
+		res, ok := responses[string(req.ID)]
+		if !ok {
+			res.size = resSize / len(reqs)
+		}
+		m.record(req.Method, req.Params, duration, rejected || res.Error != nil, req.Size, res.size)
+	}
+}
+
+// record emits the metrics of a request and logs it if it is slow.
+func (m *Monitor) record(method string, params []byte, duration time.Duration, failed bool, reqSize, resSize int) {
+	if _, ok := m.methods[method]; !ok {
+		method = unknownMethod
+	}
+	namespace := unknownMethod
+	if ns, _, ok := strings.Cut(method, "_"); ok {
+		namespace = ns
+	}
+
+	if telemetry.IsTelemetryEnabled() {
+		labels := []metrics.Label{
+			telemetry.NewLabel(labelNamespace, namespace),
+			telemetry.NewLabel(labelMethod, method),
+		}
+		telemetry.IncrCounterWithLabels(keyRequests, 1, labels)
+		if failed {
+			telemetry.IncrCounterWithLabels(keyErrors, 1, labels)
+		}
+		metrics.AddSampleWithLabels(keyDuration, float32(duration.Seconds()*1000), labels)
+		metrics.AddSampleWithLabels(keyRequestSize, float32(reqSize), labels)
+		metrics.AddSampleWithLabels(keyResponseSize, float32(resSize), labels)
+	}
+
+	if m.slowThreshold > 0 && duration >= m.slowThreshold {
+		m.slowRequest(method, ParamsHash(params), duration)
+	}
+}
+
+// QueryConn returns the gRPC connection recording the duration and the
+// failures of the queries made through the given one when the telemetry is
+// enabled, labeled by gRPC query.
+func QueryConn(conn gogogrpc.ClientConn) gogogrpc.ClientConn {
+	return queryConn{ClientConn: conn}
+}
+
+type queryConn struct {
+	gogogrpc.ClientConn
+}
+
+func (c queryConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	if !telemetry.IsTelemetryEnabled() {
+		return c.ClientConn.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	start := time.Now()
+	err := c.ClientConn.Invoke(ctx, method, args, reply, opts...)
+	labels := []metrics.Label{telemetry.NewLabel(labelQuery, method)}
+	metrics.AddSampleWithLabels(keyQueryDuration, float32(time.Since(start).Seconds()*1000), labels)
+	if err != nil {
+		telemetry.IncrCounterWithLabels(keyQueryErrors, 1, labels)
+	}
+	return err
+}
+
+// ParamsHash returns the hex encoded SHA-256 hash of the raw parameters of a
+// request, identifying the repeated slow requests without logging their
+// parameters.
+func ParamsHash(params []byte) string {
+	hash := sha256.Sum256(params)
+	return hex.EncodeToString(hash[:8])
+}
+
+// parseBatch returns the raw elements of a single or batch JSON-RPC response
+// body, or nil if the body is malformed.
+func parseBatch(body []byte) []json.RawMessage {
+	if trimmed := bytes.TrimLeft(body, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '[' {
+		var elems []json.RawMessage
+		if err := json.Unmarshal(body, &elems); err != nil {
+			return nil
+		}
+		return elems
+	}
+
+	if !json.Valid(body) {
+		return nil
+	}
+	return []json.RawMessage{body}
+}
+
+// responseRecorder records the status and the size of the response written
+// to the client, keeping its beginning to read the JSON-RPC errors.
+type responseRecorder struct {
+	http.ResponseWriter
+	status    int
+	size      int
+	inspected []byte
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if n := min(maxInspectedSize-len(r.inspected), len(b)); n > 0 {
+		r.inspected = append(r.inspected, b[:n]...)
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.size += n
+	return n, err
+}
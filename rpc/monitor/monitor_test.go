@@ -0,0 +1,324 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// testService is a service of the eth namespace serving eth_getBalance and
+// eth_chainId.
+type testService struct{}
+
+func (testService) GetBalance() {}
+
+func (testService) ChainId() {} //nolint:revive // named like the served method
+
+var testAPIs = []rpc.API{{Namespace: "eth", Service: testService{}}}
+
+type slowRequest struct {
+	method     string
+	paramsHash string
+}
+
+func newTestMonitor(t *testing.T) (*Monitor, *[]slowRequest) {
+	t.Helper()
+	var logged []slowRequest
+	monitor := NewMonitor(config.JSONRPCConfig{SlowRequestThreshold: time.Nanosecond}, testAPIs, func(method, paramsHash string, _ time.Duration) {
+		logged = append(logged, slowRequest{method, paramsHash})
+	})
+	require.True(t, monitor.Enabled())
+	return monitor, &logged
+}
+
+func TestNewMonitorDisabled(t *testing.T) {
+	monitor := NewMonitor(config.JSONRPCConfig{}, testAPIs, func(string, string, time.Duration) {})
+	require.Nil(t, monitor)
+	require.False(t, monitor.Enabled())
+	monitor.Record("eth_subscribe", 0, time.Now(), false)
+
+	next := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	require.NotNil(t, monitor.Handler(next))
+}
+
+func TestHandler(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		response string
+		expected []slowRequest
+	}{
+		{
+			"single request",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x01","latest"]}`,
+			`{"jsonrpc":"2.0","id":1,"result":"0x0"}`,
+			[]slowRequest{{"eth_getBalance", ParamsHash([]byte(`["0x01","latest"]`))}},
+		},
+		{
+			"batch request",
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"foo_bar","params":[]}]`,
+			`[{"jsonrpc":"2.0","id":1,"result":"0x1"},{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"not found"}}]`,
+			[]slowRequest{{"eth_chainId", ParamsHash(nil)}, {unknownMethod, ParamsHash([]byte(`[]`))}},
+		},
+		{
+			"malformed request",
+			`{"jsonrpc":`,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`,
+			[]slowRequest{{unknownMethod, ParamsHash(nil)}},
+		},
+		{
+			// the server serves the first request of the body
+			"request followed by other data",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}{"jsonrpc":"2.0","id":2,"method":"eth_getBalance"}`,
+			`{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+			[]slowRequest{{"eth_chainId", ParamsHash(nil)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			monitor, logged := newTestMonitor(t)
+
+			var (
+				received string
+				shared   *auth.Body
+			)
+			handler := monitor.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				shared, r = auth.ReadBody(r)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				received = string(body)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body)))
+
+			// the request and the response are passed through unchanged
+			require.Equal(t, tc.body, received)
+			// the body parsed by the monitor is shared with the next handler
+			require.Equal(t, tc.body, string(shared.Raw))
+			require.Equal(t, tc.response, rec.Body.String())
+			require.Equal(t, tc.expected, *logged)
+		})
+	}
+}
+
+func TestSlowRequestThreshold(t *testing.T) {
+	var logged []string
+	monitor := NewMonitor(config.JSONRPCConfig{SlowRequestThreshold: time.Hour}, testAPIs, func(method, _ string, _ time.Duration) {
+		logged = append(logged, method)
+	})
+
+	monitor.Record("eth_subscribe", 10, time.Now(), false)
+	require.Empty(t, logged)
+
+	monitor.Record("eth_subscribe", 10, time.Now().Add(-2*time.Hour), false)
+	require.Equal(t, []string{"eth_subscribe"}, logged)
+}
+
+// enableTelemetry enables the telemetry for the test, returning the sink of
+// the emitted metrics.
+func enableTelemetry(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	telemetry.EnableTelemetry()
+
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{})
+		require.NoError(t, err)
+		_, err = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+		require.NoError(t, err)
+	})
+	return sink
+}
+
+// counter returns the value of the counter of the given key and labels.
+func counter(sink *metrics.InmemSink, key string) float64 {
+	data := sink.Data()
+	if value, ok := data[len(data)-1].Counters[key]; ok {
+		return value.Sum
+	}
+	return 0
+}
+
+// sampleSum returns the sum of the sample of the given key and labels.
+func sampleSum(sink *metrics.InmemSink, key string) float64 {
+	data := sink.Data()
+	if value, ok := data[len(data)-1].Samples[key]; ok {
+		return value.Sum
+	}
+	return 0
+}
+
+func TestHandlerRecordsMetrics(t *testing.T) {
+	sink := enableTelemetry(t)
+	monitor := NewMonitor(config.JSONRPCConfig{}, testAPIs, nil)
+
+	testCases := []struct {
+		name   string
+		body   string
+		status int
+		// response is written by the served handler
+		response string
+		method   string
+		failed   bool
+	}{
+		{
+			"served request",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x01","latest"]}`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":1,"result":"0x0"}`,
+			"eth_getBalance",
+			false,
+		},
+		{
+			"error response",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"failed"}}`,
+			"eth_chainId",
+			true,
+		},
+		{
+			"unregistered method",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_notServed"}`,
+			http.StatusOK,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"not found"}}`,
+			unknownMethod,
+			true,
+		},
+		{
+			"rate limited request",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x01","latest"]}`,
+			http.StatusTooManyRequests,
+			"rate limit exceeded\n",
+			"eth_getBalance",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespace := "eth"
+			if tc.method == unknownMethod {
+				namespace = unknownMethod
+			}
+			key := ";namespace=" + namespace + ";method=" + tc.method
+			requests := counter(sink, "json_rpc.requests"+key)
+			errs := counter(sink, "json_rpc.errors"+key)
+			resSize := sampleSum(sink, "json_rpc.response_size"+key)
+
+			handler := monitor.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tc.status != http.StatusOK {
+					http.Error(w, strings.TrimSpace(tc.response), tc.status)
+					return
+				}
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body)))
+			require.Equal(t, tc.status, rec.Code)
+
+			require.Equal(t, requests+1, counter(sink, "json_rpc.requests"+key))
+			expectedErrs := errs
+			if tc.failed {
+				expectedErrs++
+			}
+			require.Equal(t, expectedErrs, counter(sink, "json_rpc.errors"+key))
+			require.Equal(t, resSize+float64(len(tc.response)), sampleSum(sink, "json_rpc.response_size"+key))
+		})
+	}
+}
+
+func TestHandlerLargeResponse(t *testing.T) {
+	sink := enableTelemetry(t)
+	monitor := NewMonitor(config.JSONRPCConfig{}, testAPIs, nil)
+
+	result := `{"jsonrpc":"2.0","id":1,"result":"` + strings.Repeat("0", 2*maxInspectedSize) + `"}`
+	var recorder *responseRecorder
+	handler := monitor.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		recorder = w.(*responseRecorder)
+		// the response is written in chunks, as a streamed result is
+		for i := 0; i < len(result); i += 1024 {
+			_, _ = w.Write([]byte(result[i:min(i+1024, len(result))]))
+		}
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)))
+
+	// the response is passed through, only its beginning is kept
+	require.Equal(t, result, rec.Body.String())
+	require.Len(t, recorder.inspected, maxInspectedSize)
+
+	key := ";namespace=eth;method=eth_chainId"
+	require.Equal(t, float64(1), counter(sink, "json_rpc.requests"+key))
+	require.Zero(t, counter(sink, "json_rpc.errors"+key))
+	require.Equal(t, float64(len(result)), sampleSum(sink, "json_rpc.response_size"+key))
+}
+
+func TestReject(t *testing.T) {
+	sink := enableTelemetry(t)
+	monitor := NewMonitor(config.JSONRPCConfig{}, testAPIs, nil)
+
+	body := []byte(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`)
+	reqs, err := auth.ParseRequests(body)
+	require.NoError(t, err)
+	monitor.Reject(reqs, len(body), time.Now())
+	monitor.Reject(nil, 0, time.Now())
+
+	for _, key := range []string{
+		";namespace=eth;method=eth_chainId",
+		";namespace=unknown;method=unknown",
+	} {
+		require.Equal(t, counter(sink, "json_rpc.requests"+key), counter(sink, "json_rpc.errors"+key), key)
+	}
+	require.Equal(t, float64(1), counter(sink, "json_rpc.errors;namespace=eth;method=eth_chainId"))
+	require.Equal(t, float64(2), counter(sink, "json_rpc.errors;namespace=unknown;method=unknown"))
+	require.Equal(t, float64(len(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)), sampleSum(sink, "json_rpc.request_size;namespace=eth;method=eth_chainId"))
+}
+
+// testConn is a gRPC connection answering the queries with its error.
+type testConn struct {
+	grpc.ClientConnInterface
+	err error
+}
+
+func (c testConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	return c.err
+}
+
+func TestQueryConn(t *testing.T) {
+	sink := enableTelemetry(t)
+	query := "/cosmos.evm.vm.v1.Query/Balance"
+
+	require.NoError(t, QueryConn(testConn{}).Invoke(context.Background(), query, nil, nil))
+	err := errors.New("query failed")
+	require.ErrorIs(t, QueryConn(testConn{err: err}).Invoke(context.Background(), query, nil, nil), err)
+
+	key := ";query=" + query
+	data := sink.Data()
+	require.Equal(t, 2, data[len(data)-1].Samples["json_rpc.backend.duration"+key].Count)
+	require.Equal(t, float64(1), counter(sink, "json_rpc.backend.errors"+key))
+}
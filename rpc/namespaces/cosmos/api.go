@@ -0,0 +1,58 @@
+package cosmos
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// PublicAPI offers the cosmos_ prefixed set of APIs bridging the EVM and the
+// Cosmos SDK modules for EVM-only clients.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates a new Cosmos API service.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "cosmos"),
+		backend: backend,
+	}
+}
+
+// AddressToBech32 returns the bech32 encoding of the given address, with the
+// account address prefix of the chain or the given prefix.
+func (api *PublicAPI) AddressToBech32(address common.Address, prefix *string) (string, error) {
+	api.logger.Debug("cosmos_addressToBech32", "address", address.Hex())
+	if prefix == nil {
+		return sdk.AccAddress(address.Bytes()).String(), nil
+	}
+	return bech32.ConvertAndEncode(*prefix, address.Bytes())
+}
+
+// GetCosmosTx returns the Cosmos transaction wrapping the Ethereum transaction
+// with the given hash, with its execution result.
+func (api *PublicAPI) GetCosmosTx(hash common.Hash) (*types.CosmosTxResult, error) {
+	api.logger.Debug("cosmos_getCosmosTx", "hash", hash.Hex())
+	return api.backend.GetCosmosTx(hash)
+}
+
+// GetBalances returns the bank balances of all the denoms of the given
+// address, with the ERC20 tokens they are mapped to.
+func (api *PublicAPI) GetBalances(address common.Address) ([]types.CosmosBalance, error) {
+	api.logger.Debug("cosmos_getBalances", "address", address.Hex())
+	return api.backend.GetCosmosBalances(address)
+}
+
+// GetDelegations returns the staking delegations of the given address.
+func (api *PublicAPI) GetDelegations(address common.Address) ([]types.CosmosDelegation, error) {
+	api.logger.Debug("cosmos_getDelegations", "address", address.Hex())
+	return api.backend.GetCosmosDelegations(address)
+}
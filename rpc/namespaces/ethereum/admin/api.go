@@ -0,0 +1,216 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// protocolName is the name of the protocol of the node and peer infos.
+const protocolName = "cometbft"
+
+var (
+	// errRemovePeerUnsupported is returned by admin_removePeer and
+	// admin_removeTrustedPeer as CometBFT can't disconnect peers over RPC.
+	errRemovePeerUnsupported = errors.New("removing peers is not supported by CometBFT")
+	// errUnsafeRPCDisabled is returned by admin_addPeer and
+	// admin_addTrustedPeer unless the unsafe CometBFT RPC is enabled, as
+	// its dial_peers route is.
+	errUnsafeRPCDisabled = errors.New("dialing peers requires the unsafe CometBFT RPC, enable rpc.unsafe in config.toml")
+)
+
+// peerDialer is implemented by the CometBFT clients able to dial peers, such
+// as the local client of the in-process node.
+type peerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// NodeInfo is the information about the node, in the format of the geth
+// admin_nodeInfo result. The enode is the CometBFT address of the node.
+type NodeInfo struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Enode string `json:"enode"`
+	IP    string `json:"ip"`
+	Ports struct {
+		Discovery int `json:"discovery"`
+		Listener  int `json:"listener"`
+	} `json:"ports"`
+	ListenAddr string                 `json:"listenAddr"`
+	Protocols  map[string]interface{} `json:"protocols"`
+}
+
+// PeerInfo is the information about a connected peer, in the format of the
+// geth admin_peers result. The enode is the CometBFT address of the peer.
+type PeerInfo struct {
+	Enode   string   `json:"enode"`
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Caps    []string `json:"caps"`
+	Network struct {
+		LocalAddress  string `json:"localAddress"`
+		RemoteAddress string `json:"remoteAddress"`
+		Inbound       bool   `json:"inbound"`
+		Trusted       bool   `json:"trusted"`
+		Static        bool   `json:"static"`
+	} `json:"network"`
+	Protocols map[string]interface{} `json:"protocols"`
+}
+
+// API is the admin_ prefixed set of APIs, managing the CometBFT peers of the
+// node.
+type API struct {
+	logger   log.Logger
+	dataDir  string
+	tmClient rpcclient.Client
+	// unsafeRPC reports whether the unsafe CometBFT RPC routes, such as
+	// dial_peers, are enabled
+	unsafeRPC bool
+}
+
+// NewAPI creates an instance of the Admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context) *API {
+	return &API{
+		logger:    ctx.Logger.With("api", "admin"),
+		dataDir:   ctx.Config.RootDir,
+		tmClient:  clientCtx.Client.(rpcclient.Client),
+		unsafeRPC: ctx.Config.RPC.Unsafe,
+	}
+}
+
+// Datadir returns the home directory of the node.
+func (a *API) Datadir() string {
+	a.logger.Debug("admin_datadir")
+	return a.dataDir
+}
+
+// NodeInfo returns the information about the node, from the CometBFT status.
+func (a *API) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	a.logger.Debug("admin_nodeInfo")
+	status, err := a.tmClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &NodeInfo{
+		ID:         string(status.NodeInfo.ID()),
+		Name:       nodeName(status.NodeInfo),
+		ListenAddr: trimScheme(status.NodeInfo.ListenAddr),
+		Protocols: map[string]interface{}{
+			protocolName: map[string]interface{}{
+				"network":           status.NodeInfo.Network,
+				"version":           status.NodeInfo.Version,
+				"latestBlockHash":   status.SyncInfo.LatestBlockHash,
+				"latestBlockHeight": status.SyncInfo.LatestBlockHeight,
+				"catchingUp":        status.SyncInfo.CatchingUp,
+			},
+		},
+	}
+	info.Enode = fmt.Sprintf("%s@%s", info.ID, info.ListenAddr)
+	if host, port, err := net.SplitHostPort(info.ListenAddr); err == nil {
+		info.IP = host
+		info.Ports.Listener, _ = strconv.Atoi(port)
+	}
+	return info, nil
+}
+
+// Peers returns the information about the connected peers, from the CometBFT
+// net info.
+func (a *API) Peers(ctx context.Context) ([]*PeerInfo, error) {
+	a.logger.Debug("admin_peers")
+	netInfo, err := a.tmClient.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		info := &PeerInfo{
+			ID:   string(peer.NodeInfo.ID()),
+			Name: nodeName(peer.NodeInfo),
+			Caps: []string{fmt.Sprintf("%s/%d", protocolName, peer.NodeInfo.ProtocolVersion.P2P)},
+			Protocols: map[string]interface{}{
+				protocolName: map[string]interface{}{
+					"network": peer.NodeInfo.Network,
+					"version": peer.NodeInfo.Version,
+				},
+			},
+		}
+		remoteAddress := peer.RemoteIP
+		if _, port, err := net.SplitHostPort(trimScheme(peer.NodeInfo.ListenAddr)); err == nil {
+			remoteAddress = net.JoinHostPort(peer.RemoteIP, port)
+		}
+		info.Enode = fmt.Sprintf("%s@%s", info.ID, remoteAddress)
+		info.Network.RemoteAddress = remoteAddress
+		info.Network.Inbound = !peer.IsOutbound
+		peers = append(peers, info)
+	}
+	return peers, nil
+}
+
+// AddPeer dials the peer with the given CometBFT address (id@host:port) and
+// keeps reconnecting to it, like a geth static peer. Like the peers dialed by
+// the CometBFT RPC, it requires the unsafe CometBFT RPC to be enabled.
+func (a *API) AddPeer(ctx context.Context, url string) (bool, error) {
+	a.logger.Debug("admin_addPeer", "url", url)
+	return a.dialPeer(ctx, url, true, false)
+}
+
+// AddTrustedPeer dials the peer with the given CometBFT address (id@host:port)
+// regardless of the peers limit, like a geth trusted peer. It requires the
+// unsafe CometBFT RPC to be enabled.
+func (a *API) AddTrustedPeer(ctx context.Context, url string) (bool, error) {
+	a.logger.Debug("admin_addTrustedPeer", "url", url)
+	return a.dialPeer(ctx, url, false, true)
+}
+
+// RemovePeer is not supported by CometBFT.
+func (a *API) RemovePeer(url string) (bool, error) {
+	a.logger.Debug("admin_removePeer", "url", url)
+	return false, errRemovePeerUnsupported
+}
+
+// RemoveTrustedPeer is not supported by CometBFT.
+func (a *API) RemoveTrustedPeer(url string) (bool, error) {
+	a.logger.Debug("admin_removeTrustedPeer", "url", url)
+	return false, errRemovePeerUnsupported
+}
+
+func (a *API) dialPeer(ctx context.Context, url string, persistent, unconditional bool) (bool, error) {
+	if !a.unsafeRPC {
+		return false, errUnsafeRPCDisabled
+	}
+	dialer, ok := a.tmClient.(peerDialer)
+	if !ok {
+		return false, errors.New("dialing peers is not supported by the CometBFT client")
+	}
+	if _, err := dialer.DialPeers(ctx, []string{url}, persistent, unconditional, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// nodeName returns the moniker and the CometBFT version of a node.
+func nodeName(info p2p.DefaultNodeInfo) string {
+	return fmt.Sprintf("%s/%s", info.Moniker, info.Version)
+}
+
+// trimScheme removes the scheme of a CometBFT address, such as tcp://.
+func trimScheme(addr string) string {
+	if i := strings.Index(addr, "://"); i >= 0 {
+		return addr[i+3:]
+	}
+	return addr
+}
@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+)
+
+type fakeClient struct {
+	rpcclient.Client
+	dialed        []string
+	persistent    bool
+	unconditional bool
+}
+
+func (c *fakeClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{
+			DefaultNodeID: "aaaa",
+			ListenAddr:    "tcp://127.0.0.1:26656",
+			Network:       "cosmos_262144-1",
+			Version:       "0.38.19",
+			Moniker:       "node0",
+		},
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 5},
+	}, nil
+}
+
+func (c *fakeClient) NetInfo(context.Context) (*coretypes.ResultNetInfo, error) {
+	return &coretypes.ResultNetInfo{
+		NPeers: 1,
+		Peers: []coretypes.Peer{{
+			NodeInfo: p2p.DefaultNodeInfo{
+				ProtocolVersion: p2p.ProtocolVersion{P2P: 8},
+				DefaultNodeID:   "bbbb",
+				ListenAddr:      "tcp://0.0.0.0:26666",
+				Version:         "0.38.19",
+				Moniker:         "node1",
+			},
+			IsOutbound: true,
+			RemoteIP:   "10.0.0.2",
+		}},
+	}, nil
+}
+
+type dialerClient struct{ *fakeClient }
+
+func (c dialerClient) DialPeers(_ context.Context, peers []string, persistent, unconditional, _ bool) (*coretypes.ResultDialPeers, error) {
+	c.dialed = append(c.dialed, peers...)
+	c.persistent, c.unconditional = persistent, unconditional
+	return &coretypes.ResultDialPeers{}, nil
+}
+
+func newTestAPI(client rpcclient.Client) *API {
+	return &API{logger: log.NewNopLogger(), dataDir: "/home/node", tmClient: client, unsafeRPC: true}
+}
+
+func TestNodeInfo(t *testing.T) {
+	info, err := newTestAPI(&fakeClient{}).NodeInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "aaaa", info.ID)
+	require.Equal(t, "node0/0.38.19", info.Name)
+	require.Equal(t, "aaaa@127.0.0.1:26656", info.Enode)
+	require.Equal(t, "127.0.0.1", info.IP)
+	require.Equal(t, 26656, info.Ports.Listener)
+}
+
+func TestPeers(t *testing.T) {
+	peers, err := newTestAPI(&fakeClient{}).Peers(context.Background())
+	require.NoError(t, err)
+	require.Len(t, peers, 1)
+	require.Equal(t, "bbbb@10.0.0.2:26666", peers[0].Enode)
+	require.Equal(t, []string{"cometbft/8"}, peers[0].Caps)
+	require.Equal(t, "10.0.0.2:26666", peers[0].Network.RemoteAddress)
+	require.False(t, peers[0].Network.Inbound)
+}
+
+func TestAddPeer(t *testing.T) {
+	api := newTestAPI(&fakeClient{})
+	_, err := api.AddPeer(context.Background(), "bbbb@10.0.0.2:26666")
+	require.Error(t, err)
+
+	// the peers are only dialed if the unsafe CometBFT RPC is enabled
+	client := dialerClient{&fakeClient{}}
+	api = newTestAPI(client)
+	api.unsafeRPC = false
+	_, err = api.AddPeer(context.Background(), "bbbb@10.0.0.2:26666")
+	require.ErrorIs(t, err, errUnsafeRPCDisabled)
+	_, err = api.AddTrustedPeer(context.Background(), "bbbb@10.0.0.2:26666")
+	require.ErrorIs(t, err, errUnsafeRPCDisabled)
+	require.Empty(t, client.dialed)
+
+	api.unsafeRPC = true
+	ok, err := api.AddPeer(context.Background(), "bbbb@10.0.0.2:26666")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{"bbbb@10.0.0.2:26666"}, client.dialed)
+	require.True(t, client.persistent)
+
+	ok, err = api.AddTrustedPeer(context.Background(), "cccc@10.0.0.3:26656")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, client.unconditional)
+	require.False(t, client.persistent)
+
+	_, err = api.RemovePeer("bbbb@10.0.0.2:26666")
+	require.ErrorIs(t, err, errRemovePeerUnsupported)
+	require.Equal(t, "/home/node", api.Datadir())
+}
@@ -147,6 +147,18 @@ func (a *API) TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.Bl
 	return a.backend.TraceCall(args, blockNrOrHash, config)
 }
 
+// TraceCallMany lets you trace bundles of dependent calls. The calls are
+// executed sequentially on top of the given block, each call seeing the state
+// changes of the previous ones, and the traces of the calls of each bundle are
+// returned.
+func (a *API) TraceCallMany(bundles []rpctypes.Bundle, simulationContext rpctypes.StateContext, config *rpctypes.TraceCallConfig) ([][]interface{}, error) {
+	a.logger.Debug("debug_traceCallMany", "bundles", len(bundles), "block number or hash", simulationContext.BlockNumber)
+	if idx := simulationContext.TransactionIndex; idx != nil && *idx != -1 {
+		return nil, fmt.Errorf("transaction index %d is not supported: only the state at the end of the block (transactionIndex -1) is supported", *idx)
+	}
+	return a.backend.TraceCallMany(bundles, simulationContext.BlockNumber, config)
+}
+
 // GetRawBlock retrieves the RLP-encoded block by block number or hash.
 func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
@@ -170,6 +182,106 @@ func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Byt
 	return rlp.EncodeToBytes(block)
 }
 
+// StorageRangeAt returns a page of the storage of the given contract as seen
+// before the execution of the transaction at the given index of the block.
+// The storage is iterated in key order starting from keyStart.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (*rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress)
+	return a.backend.StorageRangeAt(blockHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// GetModifiedAccountsByNumber returns the accounts modified between the two
+// given blocks, that is by the blocks after startNum up to endNum included.
+// If endNum is not set, it returns the accounts modified by the startNum block.
+func (a *API) GetModifiedAccountsByNumber(startNum uint64, endNum *uint64) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByNumber", "start", startNum, "end", endNum)
+	if startNum > math.MaxInt64 || (endNum != nil && *endNum > math.MaxInt64) {
+		return nil, errors.New("block number out of range")
+	}
+
+	start, end := int64(startNum)-1, int64(startNum) //#nosec G115 -- checked for int overflow already
+	if endNum != nil {
+		start, end = int64(startNum), int64(*endNum) //#nosec G115 -- checked for int overflow already
+	}
+	return a.backend.GetModifiedAccounts(start, end)
+}
+
+// GetModifiedAccountsByHash returns the accounts modified between the two
+// given blocks, that is by the blocks after startHash up to endHash included.
+// If endHash is not set, it returns the accounts modified by the startHash
+// block.
+func (a *API) GetModifiedAccountsByHash(startHash common.Hash, endHash *common.Hash) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByHash", "start", startHash, "end", endHash)
+	startBlock, err := a.backend.CometBlockByHash(startHash)
+	if err != nil {
+		return nil, err
+	}
+	if startBlock == nil || startBlock.Block == nil {
+		return nil, fmt.Errorf("start block %s not found", startHash.Hex())
+	}
+
+	if endHash == nil {
+		return a.backend.GetModifiedAccounts(startBlock.Block.Height-1, startBlock.Block.Height)
+	}
+
+	endBlock, err := a.backend.CometBlockByHash(*endHash)
+	if err != nil {
+		return nil, err
+	}
+	if endBlock == nil || endBlock.Block == nil {
+		return nil, fmt.Errorf("end block %s not found", endHash.Hex())
+	}
+	return a.backend.GetModifiedAccounts(startBlock.Block.Height, endBlock.Block.Height)
+}
+
+// AccountRange returns a page of the state of the accounts at the given block,
+// in address order, starting from the given address. Accounts without
+// preimages don't exist in the Cosmos state, so incompletes is ignored.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (*rpctypes.AccountRangeResult, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "max", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// DumpBlock returns the state of all the accounts at the given block, up to
+// rpctypes.MaxDumpBlockEntries accounts and storage slots.
+func (a *API) DumpBlock(blockNr rpctypes.BlockNumber) (*rpctypes.AccountRangeResult, error) {
+	a.logger.Debug("debug_dumpBlock", "number", blockNr)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}
+
+	dump := &rpctypes.AccountRangeResult{Accounts: make(map[common.Address]rpctypes.DumpAccount)}
+	var (
+		next    hexutil.Bytes
+		entries int
+	)
+	for {
+		page, err := a.backend.AccountRange(blockNrOrHash, next, 0, false, false)
+		if err != nil {
+			return nil, err
+		}
+		for addr, account := range page.Accounts {
+			if entries += 1 + len(account.Storage); entries > rpctypes.MaxDumpBlockEntries {
+				return nil, fmt.Errorf("state exceeds the maximum of %d accounts and storage slots, use debug_accountRange instead", rpctypes.MaxDumpBlockEntries)
+			}
+			dump.Accounts[addr] = account
+		}
+		if len(page.Next) == 0 {
+			return dump, nil
+		}
+		next = page.Next
+	}
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -3,6 +3,7 @@ package eth
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -12,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -50,9 +52,11 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, timeoutMs *hexutil.Uint64) (map[string]interface{}, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
-	// eth_sendPrivateTransaction
-	// eth_cancel	PrivateTransaction
+	SendPrivateTransaction(args rpctypes.PrivateTxArgs) (common.Hash, error)
+	SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error)
+	CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error)
 
 	// Account Information
 	//
@@ -61,13 +65,14 @@ type EthereumAPI interface {
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
-	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
+	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash, full *bool) (*rpctypes.AccountResult, error)
 
 	// EVM/Smart Contract Execution
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) (json.RawMessage, error)
 
 	// Chain Information
 	//
@@ -91,6 +96,7 @@ type EthereumAPI interface {
 	Syncing() (interface{}, error)
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
+	GetCodeMetadata(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.CodeMetadataResult, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
@@ -112,13 +118,15 @@ var _ EthereumAPI = (*PublicAPI)(nil)
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
+	stream  *stream.RPCStream
 }
 
 // NewPublicAPI creates an instance of the public ETH Web3 API.
-func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend, stream *stream.RPCStream) *PublicAPI {
 	api := &PublicAPI{
 		logger:  logger.With("client", "json-rpc"),
 		backend: backend,
+		stream:  stream,
 	}
 
 	return api
@@ -233,6 +241,86 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendPrivateTransaction holds a raw Ethereum transaction on this node,
+// without broadcasting it, until a block proposed by this node includes it.
+func (e *PublicAPI) SendPrivateTransaction(args rpctypes.PrivateTxArgs) (common.Hash, error) {
+	e.logger.Debug("eth_sendPrivateTransaction", "length", len(args.Tx))
+	return e.backend.SendPrivateTransaction(args)
+}
+
+// SendBundle holds a bundle of raw Ethereum transactions on this node, without
+// broadcasting them, to be included all-or-nothing in the given block if this
+// node proposes it.
+func (e *PublicAPI) SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error) {
+	e.logger.Debug("eth_sendBundle", "txs", len(args.Txs), "block", uint64(args.BlockNumber))
+	hash, err := e.backend.SendBundle(args)
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.SendBundleResult{BundleHash: hash}, nil
+}
+
+// CallBundle simulates a bundle of raw Ethereum transactions on top of the
+// state of the given block.
+func (e *PublicAPI) CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error) {
+	e.logger.Debug("eth_callBundle", "txs", len(args.Txs), "block", uint64(args.BlockNumber))
+	return e.backend.CallBundle(args)
+}
+
+// SendRawTransactionSync sends a raw Ethereum transaction and waits for its
+// inclusion in a block, returning its receipt as specified by EIP-7966. The
+// optional timeout in milliseconds is capped by the node timeout.
+func (e *PublicAPI) SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, timeoutMs *hexutil.Uint64) (map[string]interface{}, error) {
+	e.logger.Debug("eth_sendRawTransactionSync", "length", len(data))
+
+	timeout := e.backend.RPCSendRawTxSyncTimeout()
+	if timeoutMs != nil && *timeoutMs > 0 && uint64(*timeoutMs) < uint64(timeout.Milliseconds()) { //nolint:gosec // G115 // timeout is positive
+		timeout = time.Duration(*timeoutMs) * time.Millisecond //nolint:gosec // G115 // lower than the node timeout
+	}
+
+	// read the offset of the header stream before sending the transaction to
+	// not miss the block including it
+	headers := e.stream.HeaderStream()
+	_, offset := headers.ReadNonBlocking(-1)
+
+	hash, err := e.backend.SendRawTransaction(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		receipt, err := e.includedReceipt(ctx, hash)
+		if err != nil || receipt != nil {
+			return receipt, err
+		}
+		// wait for the next block
+		if _, offset = headers.ReadBlocking(ctx, offset); ctx.Err() != nil {
+			return nil, &rpctypes.TxSyncTimeoutError{Hash: hash}
+		}
+	}
+}
+
+// includedReceipt returns the receipt of the transaction, or nil if it is not
+// included in a block yet. It retries shortly since the indexer may lag behind
+// the header stream.
+func (e *PublicAPI) includedReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	for delay := 50 * time.Millisecond; ; delay *= 2 {
+		if res, err := e.backend.GetTxByEthHash(hash); err == nil && res != nil {
+			return e.backend.GetTransactionReceipt(hash)
+		}
+		if delay > 200*time.Millisecond {
+			return nil, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-time.After(delay):
+		}
+	}
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args)
@@ -267,12 +355,18 @@ func (e *PublicAPI) GetCode(address common.Address, blockNrOrHash rpctypes.Block
 	return e.backend.GetCode(address, blockNrOrHash)
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs. When
+// full is set, the commit level proof verifiable against the AppHash of the
+// next block is returned as well, see the rpc/types/proof package.
 func (e *PublicAPI) GetProof(address common.Address,
 	storageKeys []string,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
+	full *bool,
 ) (*rpctypes.AccountResult, error) {
-	e.logger.Debug("eth_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
+	e.logger.Debug("eth_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash, "full", full != nil && *full)
+	if full != nil && *full {
+		return e.backend.GetCommitProof(address, storageKeys, blockNrOrHash)
+	}
 	return e.backend.GetProof(address, storageKeys, blockNrOrHash)
 }
 
@@ -300,6 +394,13 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of calls across several simulated blocks, with
+// optional state and block overrides, on top of the given block.
+func (e *PublicAPI) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) (json.RawMessage, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+	return e.backend.SimulateV1(opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -409,6 +510,14 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 	return e.backend.GetTransactionLogs(txHash)
 }
 
+// GetCodeMetadata returns the verified deployment metadata (compiler version,
+// ABI, source hash, deployer and creation transaction) of the code of the
+// contract at the given address and block number.
+func (e *PublicAPI) GetCodeMetadata(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.CodeMetadataResult, error) {
+	e.logger.Debug("eth_getCodeMetadata", "address", address.Hex(), "block number or hash", blockNrOrHash)
+	return e.backend.GetCodeMetadata(address, blockNrOrHash)
+}
+
 // SignTypedData signs EIP-712 conformant typed data
 func (e *PublicAPI) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_signTypedData", "address", address.Hex(), "data", typedData)
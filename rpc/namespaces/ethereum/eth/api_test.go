@@ -0,0 +1,103 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+)
+
+// noEventsClient is an events client without subscriptions, the headers are
+// added to the stream by the tests.
+type noEventsClient struct{}
+
+func (noEventsClient) Subscribe(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error) {
+	return nil, errors.New("no subscriptions")
+}
+
+func (noEventsClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (noEventsClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+// syncBackend is a backend whose sent transaction is found once included.
+type syncBackend struct {
+	backend.EVMBackend
+	hash     common.Hash
+	sendErr  error
+	included atomic.Bool
+}
+
+func (b *syncBackend) RPCSendRawTxSyncTimeout() time.Duration {
+	return 5 * time.Second
+}
+
+func (b *syncBackend) SendRawTransaction(hexutil.Bytes) (common.Hash, error) {
+	return b.hash, b.sendErr
+}
+
+func (b *syncBackend) GetTxByEthHash(hash common.Hash) (*servertypes.TxResult, error) {
+	if hash != b.hash || !b.included.Load() {
+		return nil, errors.New("tx not found")
+	}
+	return &servertypes.TxResult{Height: 1}, nil
+}
+
+func (b *syncBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	return map[string]interface{}{"transactionHash": hash}, nil
+}
+
+func TestSendRawTransactionSync(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	timeoutMs := func(ms uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&ms) }
+
+	t.Run("receipt is returned once the transaction is included", func(t *testing.T) {
+		b := &syncBackend{hash: hash}
+		rpcStream := stream.NewRPCStreams(noEventsClient{}, log.NewNopLogger(), nil)
+		api := NewPublicAPI(log.NewNopLogger(), b, rpcStream)
+
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			b.included.Store(true)
+			rpcStream.HeaderStream().Add(stream.RPCHeader{})
+		}()
+
+		start := time.Now()
+		receipt, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x1}, nil)
+		require.NoError(t, err)
+		require.Equal(t, hash, receipt["transactionHash"])
+		require.Less(t, time.Since(start), 2*time.Second)
+	})
+
+	t.Run("timeout returns the transaction hash", func(t *testing.T) {
+		b := &syncBackend{hash: hash}
+		api := NewPublicAPI(log.NewNopLogger(), b, stream.NewRPCStreams(noEventsClient{}, log.NewNopLogger(), nil))
+
+		_, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x1}, timeoutMs(100))
+		var timeoutErr *rpctypes.TxSyncTimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		require.Equal(t, 4, timeoutErr.ErrorCode())
+		require.Equal(t, hash.Hex(), timeoutErr.ErrorData())
+	})
+
+	t.Run("send error is returned", func(t *testing.T) {
+		b := &syncBackend{hash: hash, sendErr: errors.New("insufficient funds")}
+		api := NewPublicAPI(log.NewNopLogger(), b, stream.NewRPCStreams(noEventsClient{}, log.NewNopLogger(), nil))
+
+		_, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x1}, timeoutMs(100))
+		require.ErrorContains(t, err, "insufficient funds")
+	})
+}
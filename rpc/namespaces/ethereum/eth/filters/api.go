@@ -335,8 +335,12 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 
 	switch f.typ {
 	case filters.PendingTransactionsSubscription:
-		var hashes []common.Hash
-		hashes, f.offset = api.events.PendingTxStream().ReadAllNonBlocking(f.offset)
+		var txs []*ethtypes.Transaction
+		txs, f.offset = api.events.PendingTxStream().ReadAllNonBlocking(f.offset)
+		hashes := make([]common.Hash, len(txs))
+		for i, tx := range txs {
+			hashes[i] = tx.Hash()
+		}
 		return returnHashes(hashes), nil
 	case filters.BlocksSubscription:
 		var headers []stream.RPCHeader
@@ -28,6 +28,12 @@ type BloomIV struct {
 	V [3]byte
 }
 
+// LogIndexBackend is implemented by the backends maintaining a log index, used
+// to skip the blocks without matching logs instead of scanning the range.
+type LogIndexBackend interface {
+	BlocksWithLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
+}
+
 // Filter can be used to retrieve and filter logs.
 type Filter struct {
 	logger   log.Logger
@@ -175,36 +181,68 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
-	for height := from; height <= to; height++ {
-		h := int64(height) //#nosec G115
-		blockRes, err := f.backend.CometBlockResultByNumber(&h)
-		if err != nil {
-			f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
-			return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
+	// only visit the blocks with matching logs if the range is indexed
+	if heights, ok := f.indexedBlocks(from, to); ok {
+		for _, height := range heights {
+			if logs, err = f.appendHeightLogs(logs, height, logLimit); err != nil {
+				return nil, err
+			}
 		}
+		return logs, nil
+	}
 
-		bloom, err := f.backend.BlockBloomFromCometBlock(blockRes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to query block bloom filter from block results: %w", err)
+	for height := from; height <= to; height++ {
+		if logs, err = f.appendHeightLogs(logs, int64(height), logLimit); err != nil { //#nosec G115
+			return nil, err
 		}
+	}
+	return logs, nil
+}
 
-		// check logs limit before fetching more
-		if len(logs) >= logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
-		}
+// indexedBlocks returns the blocks of the range which may contain logs
+// matching the filter criteria, if the backend has a log index covering it.
+func (f *Filter) indexedBlocks(from, to uint64) ([]int64, bool) {
+	indexer, ok := f.backend.(LogIndexBackend)
+	if !ok {
+		return nil, false
+	}
+	heights, ok, err := indexer.BlocksWithLogs(int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics) //#nosec G115
+	if err != nil {
+		f.logger.Debug("failed to query the log index", "from", from, "to", to, "error", err.Error())
+		return nil, false
+	}
+	return heights, ok
+}
 
-		filtered, err := f.blockLogs(blockRes, bloom)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch block by number %d: %w", height, err)
-		}
+// appendHeightLogs appends the logs of the block at the given height matching
+// the filter criteria, failing if the result exceeds the logs limit.
+func (f *Filter) appendHeightLogs(logs []*ethtypes.Log, height int64, logLimit int) ([]*ethtypes.Log, error) {
+	blockRes, err := f.backend.CometBlockResultByNumber(&height)
+	if err != nil {
+		f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
+		return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
+	}
 
-		// check logs limit
-		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
-		}
-		logs = append(logs, filtered...)
+	bloom, err := f.backend.BlockBloomFromCometBlock(blockRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query block bloom filter from block results: %w", err)
 	}
-	return logs, nil
+
+	// check logs limit before fetching more
+	if len(logs) >= logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+
+	filtered, err := f.blockLogs(blockRes, bloom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block by number %d: %w", height, err)
+	}
+
+	// check logs limit
+	if len(logs)+len(filtered) > logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	return append(logs, filtered...), nil
 }
 
 // blockLogs returns the logs matching the filter criteria within a single block.
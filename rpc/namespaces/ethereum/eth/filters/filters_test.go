@@ -307,3 +307,42 @@ func TestH7_LogLimitCheckAfterAllocation(t *testing.T) {
 	t.Log("H7 fix verified: early guard prevents blockLogs() call when at capacity; " +
 		"post-fetch guard catches single-block overflow.")
 }
+
+// indexedBackend is a backend with a log index returning fixed blocks.
+type indexedBackend struct {
+	*filtermocks.Backend
+	heights []int64
+	indexed bool
+}
+
+func (b *indexedBackend) BlocksWithLogs(_, _ int64, _ []common.Address, _ [][]common.Hash) ([]int64, bool, error) {
+	return b.heights, b.indexed, nil
+}
+
+func TestFilter_Logs_Indexed(t *testing.T) {
+	logger := log.NewNopLogger()
+
+	testCases := []struct {
+		name    string
+		indexed bool
+		expHits int
+	}{
+		{"indexed range only fetches the candidate blocks", true, 2},
+		{"range not indexed scans every block", false, 11},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &indexedBackend{Backend: filtermocks.NewBackend(t), heights: []int64{3, 7}, indexed: tc.indexed}
+			backend.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+			backend.EXPECT().CometBlockResultByNumber(mock.Anything).RunAndReturn(func(height *int64) (*cmtrpctypes.ResultBlockResults, error) {
+				return &cmtrpctypes.ResultBlockResults{Height: *height}, nil
+			}).Times(tc.expHits)
+			backend.EXPECT().BlockBloomFromCometBlock(mock.Anything).Return(ethtypes.Bloom{}, nil).Times(tc.expHits)
+
+			f := NewRangeFilter(logger, backend, 0, 10, nil, nil)
+			logs, err := f.Logs(context.Background(), 100, 100)
+			require.NoError(t, err)
+			require.Empty(t, logs)
+		})
+	}
+}
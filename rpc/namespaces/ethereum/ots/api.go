@@ -0,0 +1,278 @@
+package ots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// APILevel is the Otterscan API level implemented by the ots namespace.
+const APILevel = 8
+
+// MaxPageSize is the maximum number of transactions returned by a single
+// ots_searchTransactionsBefore or ots_searchTransactionsAfter call.
+const MaxPageSize = 100
+
+var callTraceConfig = &types.TraceConfig{
+	TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+}
+
+// callFrame is a call frame of the callTracer output.
+type callFrame struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Value  *hexutil.Big    `json:"value"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+	Calls  []*callFrame    `json:"calls"`
+}
+
+// PublicAPI offers the Otterscan compatible ots_ prefixed set of APIs used by
+// block explorers.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates a new Otterscan API service.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (api *PublicAPI) GetApiLevel() uint64 { //nolint:revive,stylecheck // method name is defined by the Otterscan spec
+	api.logger.Debug("ots_getApiLevel")
+	return APILevel
+}
+
+// GetContractCreator returns the creator and the creation transaction hash of
+// the given contract. It returns nil if the address is not a contract created
+// by a transaction.
+func (api *PublicAPI) GetContractCreator(address common.Address) (*types.ContractCreatorResult, error) {
+	api.logger.Debug("ots_getContractCreator", "address", address.Hex())
+	return api.backend.GetContractCreator(address)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the given address with the given nonce, or nil if not found.
+func (api *PublicAPI) GetTransactionBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "sender", sender.Hex(), "nonce", nonce)
+	return api.backend.GetTxHashBySenderAndNonce(sender, nonce)
+}
+
+// GetInternalOperations returns the ETH transfers, contract creations and
+// self destructs happening inside the given transaction.
+func (api *PublicAPI) GetInternalOperations(hash common.Hash) ([]*types.InternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash.Hex())
+	root, err := api.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []*types.InternalOperation{}
+	var walk func(frame *callFrame)
+	walk = func(frame *callFrame) {
+		for _, call := range frame.Calls {
+			op := &types.InternalOperation{From: call.From, Value: call.Value}
+			if call.To != nil {
+				op.To = *call.To
+			}
+			if op.Value == nil {
+				op.Value = (*hexutil.Big)(new(big.Int))
+			}
+
+			switch call.Type {
+			case "CALL":
+				op.Type = types.OtsOpTransfer
+			case "SELFDESTRUCT":
+				op.Type = types.OtsOpSelfDestruct
+			case "CREATE":
+				op.Type = types.OtsOpCreate
+			case "CREATE2":
+				op.Type = types.OtsOpCreate2
+			default:
+				op = nil
+			}
+			if op != nil && (op.Type != types.OtsOpTransfer || op.Value.ToInt().Sign() > 0) {
+				ops = append(ops, op)
+			}
+			walk(call)
+		}
+	}
+	walk(root)
+
+	return ops, nil
+}
+
+// TraceTransaction returns the call frames of the given transaction, in
+// execution order.
+func (api *PublicAPI) TraceTransaction(hash common.Hash) ([]*types.TraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash.Hex())
+	root, err := api.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*types.TraceEntry{}
+	var walk func(frame *callFrame, depth int)
+	walk = func(frame *callFrame, depth int) {
+		entry := &types.TraceEntry{
+			Type:   frame.Type,
+			Depth:  depth,
+			From:   frame.From,
+			Input:  frame.Input,
+			Output: frame.Output,
+		}
+		if frame.To != nil {
+			entry.To = *frame.To
+		}
+		if frame.Type != "STATICCALL" && frame.Type != "DELEGATECALL" {
+			entry.Value = frame.Value
+			if entry.Value == nil {
+				entry.Value = (*hexutil.Big)(new(big.Int))
+			}
+		}
+		entries = append(entries, entry)
+		for _, call := range frame.Calls {
+			walk(call, depth+1)
+		}
+	}
+	walk(root, 0)
+
+	return entries, nil
+}
+
+// SearchTransactionsBefore returns a page of the transactions sent or
+// received by the given address in the blocks before the given block number,
+// newest first. A zero block number starts from the latest block.
+//
+// Unlike Erigon, which indexes the addresses of the call traces, the
+// transactions are only indexed by their top level sender and recipient, or
+// created contract: the transactions reaching the address through internal
+// calls are not returned.
+func (api *PublicAPI) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint64) (*types.TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address.Hex(), "block", blockNumber, "pageSize", pageSize)
+	hashes, more, err := api.searchTransactions(address, blockNumber, true, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := api.withReceipts(hashes)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = blockNumber == 0
+	res.LastPage = !more
+	return res, nil
+}
+
+// SearchTransactionsAfter returns a page of the transactions sent or received
+// by the given address in the blocks after the given block number, newest
+// first. A zero block number starts from the first block. As for
+// SearchTransactionsBefore, the internal calls are not searched.
+func (api *PublicAPI) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint64) (*types.TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address.Hex(), "block", blockNumber, "pageSize", pageSize)
+	hashes, more, err := api.searchTransactions(address, blockNumber, false, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(hashes)
+
+	res, err := api.withReceipts(hashes)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = !more
+	res.LastPage = blockNumber == 0
+	return res, nil
+}
+
+func (api *PublicAPI) searchTransactions(address common.Address, blockNumber uint64, reverse bool, pageSize uint64) ([]common.Hash, bool, error) {
+	if pageSize == 0 || pageSize > MaxPageSize {
+		return nil, false, fmt.Errorf("page size must be between 1 and %d", MaxPageSize)
+	}
+	if blockNumber > math.MaxInt64 {
+		return nil, false, fmt.Errorf("invalid block number %d", blockNumber)
+	}
+	return api.backend.SearchTxHashesByAddress(address, int64(blockNumber), reverse, int(pageSize)) //#nosec G115 -- checked above
+}
+
+// withReceipts loads the transactions with the given hashes and their
+// receipts, extended with the block timestamp.
+func (api *PublicAPI) withReceipts(hashes []common.Hash) (*types.TransactionsWithReceipts, error) {
+	res := &types.TransactionsWithReceipts{
+		Txs:      make([]*types.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+
+	timestamps := make(map[uint64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := api.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := api.backend.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil || tx.BlockNumber == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+
+		height := tx.BlockNumber.ToInt().Uint64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			header, err := api.backend.HeaderByNumber(types.BlockNumber(height)) //#nosec G115 -- block numbers fit in int64
+			if err != nil {
+				return nil, err
+			}
+			timestamp = hexutil.Uint64(header.Time)
+			timestamps[height] = timestamp
+		}
+		// the receipt may be shared by the response cache of the backend
+		receipt = maps.Clone(receipt)
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// traceCalls returns the root call frame of the given transaction.
+func (api *PublicAPI) traceCalls(hash common.Hash) (*callFrame, error) {
+	res, err := api.backend.TraceTransaction(hash, callTraceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var root callFrame
+	if err := json.Unmarshal(bz, &root); err != nil {
+		return nil, err
+	}
+	if root.Type == "" {
+		return nil, errors.New("invalid call trace")
+	}
+	return &root, nil
+}
@@ -0,0 +1,156 @@
+package ots
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// searchBackend is a backend holding the hashes of the transactions of an
+// address, one per block at the height of the index of the hash. Like the
+// response cache, it returns the same receipt for each call.
+type searchBackend struct {
+	backend.EVMBackend
+	hashes   []common.Hash
+	receipts map[common.Hash]map[string]interface{}
+	trace    interface{}
+}
+
+func (b *searchBackend) SearchTxHashesByAddress(_ common.Address, blockNumber int64, reverse bool, pageSize int) ([]common.Hash, bool, error) {
+	var hashes []common.Hash
+	if reverse {
+		for i := len(b.hashes) - 1; i >= 0; i-- {
+			if blockNumber == 0 || int64(i) < blockNumber {
+				hashes = append(hashes, b.hashes[i])
+			}
+		}
+	} else {
+		for i := range b.hashes {
+			if int64(i) > blockNumber {
+				hashes = append(hashes, b.hashes[i])
+			}
+		}
+	}
+	if len(hashes) > pageSize {
+		return hashes[:pageSize], true, nil
+	}
+	return hashes, false, nil
+}
+
+func (b *searchBackend) height(hash common.Hash) int64 {
+	for i, h := range b.hashes {
+		if h == hash {
+			return int64(i)
+		}
+	}
+	return -1
+}
+
+func (b *searchBackend) GetTransactionByHash(hash common.Hash) (*types.RPCTransaction, error) {
+	return &types.RPCTransaction{Hash: hash, BlockNumber: (*hexutil.Big)(big.NewInt(b.height(hash)))}, nil
+}
+
+func (b *searchBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	if b.receipts == nil {
+		b.receipts = make(map[common.Hash]map[string]interface{})
+	}
+	if _, ok := b.receipts[hash]; !ok {
+		b.receipts[hash] = map[string]interface{}{"transactionHash": hash}
+	}
+	return b.receipts[hash], nil
+}
+
+func (b *searchBackend) HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(blockNum.Int64()), Time: uint64(blockNum.Int64()) * 10}, nil //nolint:gosec // G115 // test block numbers are positive
+}
+
+func (b *searchBackend) TraceTransaction(common.Hash, *types.TraceConfig) (interface{}, error) {
+	return b.trace, nil
+}
+
+func txHashes(txs []*types.RPCTransaction) []common.Hash {
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash
+	}
+	return hashes
+}
+
+func TestSearchTransactions(t *testing.T) {
+	hashes := []common.Hash{common.HexToHash("0x00"), common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+	b := &searchBackend{hashes: hashes}
+	api := NewPublicAPI(log.NewNopLogger(), b)
+	address := common.HexToAddress("0x01")
+
+	// the first page of the latest transactions, newest first
+	res, err := api.SearchTransactionsBefore(address, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[3], hashes[2]}, txHashes(res.Txs))
+	require.True(t, res.FirstPage)
+	require.False(t, res.LastPage)
+	require.Len(t, res.Receipts, 2)
+	require.Equal(t, hexutil.Uint64(30), res.Receipts[0]["timestamp"])
+
+	// the next page is the last one
+	res, err = api.SearchTransactionsBefore(address, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[1], hashes[0]}, txHashes(res.Txs))
+	require.False(t, res.FirstPage)
+	require.True(t, res.LastPage)
+
+	// the transactions after a block are returned newest first too
+	res, err = api.SearchTransactionsAfter(address, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[2], hashes[1]}, txHashes(res.Txs))
+	require.False(t, res.FirstPage)
+	require.True(t, res.LastPage)
+
+	res, err = api.SearchTransactionsAfter(address, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[3], hashes[2]}, txHashes(res.Txs))
+	require.True(t, res.FirstPage)
+
+	// the receipts of the backend are left unchanged
+	for _, receipt := range b.receipts {
+		require.NotContains(t, receipt, "timestamp")
+	}
+
+	// the page size is bounded
+	_, err = api.SearchTransactionsBefore(address, 0, 0)
+	require.ErrorContains(t, err, "page size")
+	_, err = api.SearchTransactionsAfter(address, 0, MaxPageSize+1)
+	require.ErrorContains(t, err, "page size")
+}
+
+func TestGetInternalOperations(t *testing.T) {
+	var trace interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "CALL", "from": "0x00000000000000000000000000000000000000a1", "to": "0x00000000000000000000000000000000000000c1", "value": "0x0",
+		"calls": [
+			{"type": "CALL", "from": "0x00000000000000000000000000000000000000c1", "to": "0x00000000000000000000000000000000000000b1", "value": "0x5"},
+			{"type": "STATICCALL", "from": "0x00000000000000000000000000000000000000c1", "to": "0x00000000000000000000000000000000000000b2"},
+			{"type": "CALL", "from": "0x00000000000000000000000000000000000000c1", "to": "0x00000000000000000000000000000000000000b3", "value": "0x0",
+				"calls": [{"type": "CREATE2", "from": "0x00000000000000000000000000000000000000b3", "to": "0x00000000000000000000000000000000000000c2", "value": "0x0"}]}
+		]
+	}`), &trace))
+	api := NewPublicAPI(log.NewNopLogger(), &searchBackend{trace: trace})
+
+	ops, err := api.GetInternalOperations(common.HexToHash("0x01"))
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	require.Equal(t, types.OtsOpTransfer, ops[0].Type)
+	require.Equal(t, common.HexToAddress("0xb1"), ops[0].To)
+	require.Equal(t, big.NewInt(5), ops[0].Value.ToInt())
+	require.Equal(t, types.OtsOpCreate2, ops[1].Type)
+	require.Equal(t, common.HexToAddress("0xc2"), ops[1].To)
+}
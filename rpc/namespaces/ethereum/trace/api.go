@@ -0,0 +1,301 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/x/vm/tracers"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	flatCallTraceConfig = &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "flatCallTracer"},
+		TracerConfig: json.RawMessage(`{"convertParityErrors":true}`),
+	}
+	stateDiffTraceConfig = &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "prestateTracer"},
+		TracerConfig: json.RawMessage(`{"diffMode":true}`),
+	}
+	vmTraceConfig = &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: tracers.VMTracerName},
+		TracerConfig: json.RawMessage(`{}`),
+	}
+)
+
+// API offers the Parity compatible trace_ prefixed set of APIs, built on top
+// of the debug tracing queries of the EVM module.
+type API struct {
+	logger        log.Logger
+	backend       backend.EVMBackend
+	blockRangeCap int32
+}
+
+// NewAPI creates a new Parity trace API service. The block range of
+// trace_filter, whose blocks are all traced, is limited to blockRangeCap
+// blocks.
+func NewAPI(logger log.Logger, backend backend.EVMBackend, blockRangeCap int32) *API {
+	return &API{
+		logger:        logger.With("module", "trace"),
+		backend:       backend,
+		blockRangeCap: blockRangeCap,
+	}
+}
+
+// Block returns the flat call traces of all the transactions of the given
+// block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	return api.blockTraces(blockNr)
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash.Hex())
+	res, err := api.backend.TraceTransaction(hash, flatCallTraceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*rpctypes.FlatTrace
+	if err := remarshal(res, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// Filter returns the flat call traces of the given block range matching the
+// given sender and recipient addresses. The blocks are traced one after the
+// other until the requested number of traces is found or the request is
+// cancelled.
+func (api *API) Filter(ctx context.Context, args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := rpctypes.BlockNumber(latest), rpctypes.BlockNumber(latest)
+	if args.FromBlock != nil {
+		from = resolveBlockNumber(*args.FromBlock, uint64(latest))
+	}
+	if args.ToBlock != nil {
+		to = resolveBlockNumber(*args.ToBlock, uint64(latest))
+	}
+	if from < 1 {
+		from = 1
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d > to %d", from, to)
+	}
+	if api.blockRangeCap > 0 && int64(to-from) >= int64(api.blockRangeCap) {
+		return nil, fmt.Errorf("block range exceeds the maximum of %d blocks", api.blockRangeCap)
+	}
+
+	traces := []*rpctypes.FlatTrace{}
+	if args.Count != nil && *args.Count == 0 {
+		return traces, nil
+	}
+	var after uint64
+	if args.After != nil {
+		after = *args.After
+	}
+
+	for blockNr := from; blockNr <= to; blockNr++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		blockTraces, err := api.blockTraces(blockNr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !matchAddress(trace.Sender(), args.FromAddress) || !matchAddress(trace.Recipient(), args.ToAddress) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested trace types ("trace", "stateDiff" and "vmTrace") of
+// each of them.
+func (api *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case rpctypes.TraceTypeTrace, rpctypes.TraceTypeStateDiff, rpctypes.TraceTypeVMTrace:
+		default:
+			return nil, fmt.Errorf("invalid trace type %q", traceType)
+		}
+	}
+
+	resBlock, err := api.backend.CometBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	height := rpctypes.BlockNumber(resBlock.Block.Height)
+
+	// the call traces are always needed for the transaction hashes and outputs
+	callTraces, err := api.backend.TraceBlock(height, flatCallTraceConfig, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.TraceResults, len(callTraces))
+	for i, res := range callTraces {
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d: %s", i, res.Error)
+		}
+
+		var traces []*rpctypes.FlatTrace
+		if err := remarshal(res.Result, &traces); err != nil {
+			return nil, err
+		}
+
+		result := &rpctypes.TraceResults{Output: []byte{}}
+		if len(traces) > 0 {
+			result.Output = traces[0].Output()
+			if traces[0].TransactionHash != nil {
+				result.TransactionHash = *traces[0].TransactionHash
+			}
+		}
+		if slices.Contains(traceTypes, rpctypes.TraceTypeTrace) {
+			for _, trace := range traces {
+				trace.BlockHash = nil
+				trace.BlockNumber = nil
+				trace.TransactionHash = nil
+				trace.TransactionPosition = nil
+			}
+			result.Trace = traces
+		}
+		results[i] = result
+	}
+
+	if slices.Contains(traceTypes, rpctypes.TraceTypeStateDiff) {
+		diffs, err := api.backend.TraceBlock(height, stateDiffTraceConfig, resBlock)
+		if err != nil {
+			return nil, err
+		}
+		for i, res := range diffs {
+			if i >= len(results) || res.Error != "" {
+				continue
+			}
+			var diff rpctypes.PrestateDiff
+			if err := remarshal(res.Result, &diff); err != nil {
+				return nil, err
+			}
+			results[i].StateDiff = rpctypes.NewStateDiff(&diff)
+		}
+	}
+
+	if slices.Contains(traceTypes, rpctypes.TraceTypeVMTrace) {
+		vmTraces, err := api.backend.TraceBlock(height, vmTraceConfig, resBlock)
+		if err != nil {
+			return nil, err
+		}
+		for i, res := range vmTraces {
+			if i >= len(results) || res.Error != "" {
+				continue
+			}
+			bz, err := json.Marshal(res.Result)
+			if err != nil {
+				return nil, err
+			}
+			results[i].VMTrace = bz
+		}
+	}
+
+	return results, nil
+}
+
+// blockTraces returns the flat call traces of all the transactions of the
+// given block.
+func (api *API) blockTraces(blockNr rpctypes.BlockNumber) ([]*rpctypes.FlatTrace, error) {
+	resBlock, err := api.backend.CometBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), flatCallTraceConfig, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*rpctypes.FlatTrace{}
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d: %s", i, res.Error)
+		}
+		var txTraces []*rpctypes.FlatTrace
+		if err := remarshal(res.Result, &txTraces); err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// resolveBlockNumber converts the special block numbers to the given latest
+// block number.
+func resolveBlockNumber(blockNr rpctypes.BlockNumber, latest uint64) rpctypes.BlockNumber {
+	switch blockNr {
+	case rpctypes.EthLatestBlockNumber, rpctypes.EthPendingBlockNumber, rpctypes.EthSafeBlockNumber, rpctypes.EthFinalizedBlockNumber:
+		return rpctypes.BlockNumber(latest) //#nosec G115 -- block numbers fit in int64
+	case rpctypes.EthEarliestBlockNumber:
+		return 1
+	default:
+		return blockNr
+	}
+}
+
+// matchAddress reports whether the address is in the given set. An empty set
+// matches any address.
+func matchAddress(addr *common.Address, set []common.Address) bool {
+	if len(set) == 0 {
+		return true
+	}
+	return addr != nil && slices.Contains(set, *addr)
+}
+
+// remarshal converts a generic tracer result to the given type.
+func remarshal(in, out interface{}) error {
+	bz, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, out)
+}
@@ -0,0 +1,284 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// ErrCodeLimitExceeded is the JSON-RPC error code returned for requests
+	// exceeding the rate limit of their client.
+	ErrCodeLimitExceeded = -32005
+	// ErrMsgLimitExceeded is the JSON-RPC error message returned for requests
+	// exceeding the rate limit of their client.
+	ErrMsgLimitExceeded = "limit exceeded"
+
+	// pruneThreshold is the number of tracked clients above which the idle
+	// buckets are dropped.
+	pruneThreshold = 10_000
+)
+
+var (
+	limitedMeter = metrics.NewRegisteredMeter("rpc/ratelimit/limited", nil)
+	costMeter    = metrics.NewRegisteredMeter("rpc/ratelimit/cost", nil)
+	clientsGauge = metrics.NewRegisteredGauge("rpc/ratelimit/clients", nil)
+)
+
+// bucket is the token bucket of a client. Its tokens are negative while the
+// client repays a request costing more than the burst.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter rate limits the JSON-RPC requests of each client with a token
+// bucket, refilled at a constant rate and spent according to the cost of the
+// called methods.
+//
+// The clients are identified by their API key, or else by their IP address.
+// Behind a reverse proxy, all the clients share the address of the proxy
+// unless it is configured as trusted, its X-Forwarded-For header then
+// identifying them.
+type Limiter struct {
+	rate  float64
+	burst float64
+	// costs holds the lowercase method and namespace costs, as viper lowercases
+	// the map keys of the configuration.
+	costs   map[string]int
+	proxies []netip.Prefix
+	now     func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewLimiter creates a Limiter from the JSON-RPC configuration. It returns
+// nil if rate limiting is disabled.
+func NewLimiter(cfg config.JSONRPCConfig) *Limiter {
+	if cfg.RateLimit <= 0 {
+		return nil
+	}
+
+	costs := make(map[string]int, len(cfg.MethodCosts))
+	for method, cost := range cfg.MethodCosts {
+		costs[strings.ToLower(method)] = cost
+	}
+
+	var proxies []netip.Prefix
+	for _, proxy := range cfg.TrustedProxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			proxies = append(proxies, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(proxy); err == nil {
+			proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+		}
+	}
+
+	return &Limiter{
+		rate:    cfg.RateLimit,
+		burst:   float64(cfg.RateLimitBurst),
+		costs:   costs,
+		proxies: proxies,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Enabled reports whether the requests are rate limited.
+func (l *Limiter) Enabled() bool {
+	return l != nil
+}
+
+// Cost returns the cost units of the given method, from its own configured
+// cost or else the one of its namespace. Other methods cost 1 unit.
+func (l *Limiter) Cost(method string) int {
+	method = strings.ToLower(method)
+	if cost, ok := l.costs[method]; ok {
+		return cost
+	}
+	if namespace, _, ok := strings.Cut(method, "_"); ok {
+		if cost, ok := l.costs[namespace]; ok {
+			return cost
+		}
+	}
+	return 1
+}
+
+// Allow spends the cost of the given requests from the bucket of the client,
+// and reports whether it had enough tokens left. An empty batch costs 1 unit.
+// A request or batch costing more than the burst is only allowed from a
+// full bucket, which is charged its whole cost and stays in debt until
+// refilled.
+func (l *Limiter) Allow(client string, reqs []auth.Request) bool {
+	if l == nil {
+		return true
+	}
+
+	cost := 0
+	for _, req := range reqs {
+		cost += l.Cost(req.Method)
+	}
+	if len(reqs) == 0 {
+		cost = 1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[client]
+	if !ok {
+		if len(l.buckets) >= pruneThreshold {
+			l.prune(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[client] = b
+		clientsGauge.Update(int64(len(l.buckets)))
+	}
+
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < min(float64(cost), l.burst) {
+		limitedMeter.Mark(1)
+		return false
+	}
+
+	b.tokens -= float64(cost)
+	costMeter.Mark(int64(cost))
+	return true
+}
+
+// prune drops the buckets refilled since their last request, which are
+// equivalent to new ones.
+func (l *Limiter) prune(now time.Time) {
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// Handler returns an HTTP handler rate limiting the requests before passing
+// them to the given handler. It must be wrapped by the auth handler to
+// identify the clients by their API key, and lets the requests forwarded by
+// the WebSocket server through as they are limited on their connection. The
+// request bodies that cannot be parsed are rejected, as their cost is
+// unknown.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	if !l.Enabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth.Forwarded(r.Context()) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, r := auth.ReadBody(r)
+		if body.Err != nil {
+			auth.WriteError(w, http.StatusBadRequest, auth.ErrCodeInvalidRequest, body.Err.Error())
+			return
+		}
+		if !l.Allow(l.ClientID(auth.PermissionsFromContext(r.Context()), r), body.Requests) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_ = json.NewEncoder(w).Encode(LimitExceededResponse(body.Raw, body.Requests))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ClientID identifies the client of a request by its API key if it is
+// authenticated, or else by its IP address. The address of the requests
+// forwarded by a trusted proxy is the last untrusted one of their
+// X-Forwarded-For header, as the proxies append the address they receive the
+// request from.
+func (l *Limiter) ClientID(perms *auth.Permissions, r *http.Request) string {
+	if perms != nil && perms.KeyID != "" {
+		return "key:" + perms.KeyID
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !l.trusted(host) {
+		return "ip:" + host
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if addr == "" {
+			continue
+		}
+		host = addr
+		if !l.trusted(addr) {
+			break
+		}
+	}
+	return "ip:" + host
+}
+
+// trusted reports whether the given address is one of a trusted proxy.
+func (l *Limiter) trusted(host string) bool {
+	if l == nil || len(l.proxies) == 0 {
+		return false
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, proxy := range l.proxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// LimitExceededResponse returns the JSON-RPC error response of the given
+// single or batch request exceeding the rate limit.
+func LimitExceededResponse(body []byte, reqs []auth.Request) interface{} {
+	newError := func(id json.RawMessage) map[string]interface{} {
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      id,
+			"error": map[string]interface{}{
+				"code":    ErrCodeLimitExceeded,
+				"message": ErrMsgLimitExceeded,
+			},
+		}
+	}
+
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' || len(reqs) == 0 {
+		if len(reqs) == 1 {
+			return newError(reqs[0].ID)
+		}
+		return newError(nil)
+	}
+
+	res := make([]map[string]interface{}, 0, len(reqs))
+	for _, req := range reqs {
+		res = append(res, newError(req.ID))
+	}
+	return res
+}
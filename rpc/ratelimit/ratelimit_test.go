@@ -0,0 +1,199 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/server/config"
+)
+
+func newTestLimiter(t *testing.T) (*Limiter, *time.Time) {
+	t.Helper()
+	limiter := NewLimiter(config.JSONRPCConfig{
+		RateLimit:      2,
+		RateLimitBurst: 10,
+		MethodCosts:    map[string]int{"eth_getlogs": 5, "debug": 8, "debug_traceBlockByNumber": 20},
+	})
+	require.True(t, limiter.Enabled())
+
+	now := time.Unix(1_700_000_000, 0)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestNewLimiterDisabled(t *testing.T) {
+	limiter := NewLimiter(config.JSONRPCConfig{RateLimitBurst: 10})
+	require.Nil(t, limiter)
+	require.False(t, limiter.Enabled())
+	require.True(t, limiter.Allow("ip:127.0.0.1", []auth.Request{{Method: "eth_getLogs"}}))
+}
+
+func TestCost(t *testing.T) {
+	limiter, _ := newTestLimiter(t)
+
+	testCases := []struct {
+		method  string
+		expCost int
+	}{
+		{"eth_blockNumber", 1},
+		{"eth_getLogs", 5},
+		{"debug_traceTransaction", 8},
+		{"debug_traceBlockByNumber", 20},
+		{"malformed", 1},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expCost, limiter.Cost(tc.method), tc.method)
+	}
+}
+
+func TestAllow(t *testing.T) {
+	limiter, now := newTestLimiter(t)
+	getLogs := []auth.Request{{Method: "eth_getLogs"}}
+
+	require.True(t, limiter.Allow("ip:1.1.1.1", getLogs))
+	require.True(t, limiter.Allow("ip:1.1.1.1", getLogs))
+	require.False(t, limiter.Allow("ip:1.1.1.1", getLogs))
+
+	// other clients have their own bucket
+	require.True(t, limiter.Allow("ip:2.2.2.2", getLogs))
+
+	// 2 units per second are refilled
+	*now = now.Add(2 * time.Second)
+	require.False(t, limiter.Allow("ip:1.1.1.1", getLogs))
+	*now = now.Add(time.Second)
+	require.True(t, limiter.Allow("ip:1.1.1.1", getLogs))
+
+	// a request costing more than the burst needs a full bucket, and leaves
+	// it in debt of the cost above the burst
+	traceBlock := []auth.Request{{Method: "debug_traceBlockByNumber"}}
+	*now = now.Add(4 * time.Second)
+	require.False(t, limiter.Allow("ip:1.1.1.1", traceBlock))
+	*now = now.Add(time.Second)
+	require.True(t, limiter.Allow("ip:1.1.1.1", traceBlock))
+	require.False(t, limiter.Allow("ip:1.1.1.1", nil))
+	*now = now.Add(5 * time.Second)
+	require.False(t, limiter.Allow("ip:1.1.1.1", nil))
+	*now = now.Add(500 * time.Millisecond)
+	require.True(t, limiter.Allow("ip:1.1.1.1", nil))
+
+	// batches are charged the cost of all their requests
+	require.True(t, limiter.Allow("ip:3.3.3.3", []auth.Request{{Method: "eth_blockNumber"}}))
+	require.False(t, limiter.Allow("ip:3.3.3.3", append(getLogs, getLogs...)))
+}
+
+func TestAllowBatchOverBurst(t *testing.T) {
+	limiter, now := newTestLimiter(t)
+	getLogs := []auth.Request{{Method: "eth_getLogs"}}
+	batch := []auth.Request{{Method: "eth_getLogs"}, {Method: "eth_getLogs"}, {Method: "eth_getLogs"}, {Method: "eth_getLogs"}}
+
+	// the batch costing 20 units is charged in full from the 10 units burst
+	require.True(t, limiter.Allow("ip:1.1.1.1", batch))
+	require.False(t, limiter.Allow("ip:1.1.1.1", batch))
+
+	// the client repays the 10 units of debt before spending again
+	*now = now.Add(7 * time.Second)
+	require.False(t, limiter.Allow("ip:1.1.1.1", getLogs))
+	*now = now.Add(500 * time.Millisecond)
+	require.True(t, limiter.Allow("ip:1.1.1.1", getLogs))
+
+	// repeated batches are limited to the refill rate rather than the burst
+	*now = now.Add(10 * time.Second)
+	require.True(t, limiter.Allow("ip:1.1.1.1", batch))
+	*now = now.Add(5 * time.Second)
+	require.False(t, limiter.Allow("ip:1.1.1.1", batch))
+}
+
+func TestClientID(t *testing.T) {
+	request := func(remoteAddr, forwardedFor string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			r.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		return r
+	}
+
+	var disabled *Limiter
+	require.Equal(t, "ip:1.2.3.4", disabled.ClientID(nil, request("1.2.3.4:5678", "")))
+	require.Equal(t, "ip:1.2.3.4", disabled.ClientID(&auth.Permissions{}, request("1.2.3.4", "")))
+	require.Equal(t, "key:indexer", disabled.ClientID(&auth.Permissions{KeyID: "indexer"}, request("1.2.3.4:5678", "")))
+
+	limiter := NewLimiter(config.JSONRPCConfig{RateLimit: 1, RateLimitBurst: 1, TrustedProxies: []string{"10.0.0.0/8", "127.0.0.1"}})
+
+	testCases := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		expID        string
+	}{
+		{"untrusted proxy", "1.2.3.4:5678", "5.6.7.8", "ip:1.2.3.4"},
+		{"trusted proxy", "127.0.0.1:5678", "5.6.7.8", "ip:5.6.7.8"},
+		{"chained trusted proxies", "10.0.0.1:5678", "6.6.6.6, 5.6.7.8, 10.0.0.2", "ip:5.6.7.8"},
+		{"spoofed header", "10.0.0.1:5678", "6.6.6.6, 5.6.7.8", "ip:5.6.7.8"},
+		{"trusted proxy without header", "10.0.0.1:5678", "", "ip:10.0.0.1"},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expID, limiter.ClientID(nil, request(tc.remoteAddr, tc.forwardedFor)), tc.name)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	limiter, _ := newTestLimiter(t)
+	authenticator, err := auth.NewAuthenticator(config.JSONRPCConfig{})
+	require.NoError(t, err)
+	internalToken, err := authenticator.InternalToken()
+	require.NoError(t, err)
+
+	handler := authenticator.Handler(limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	do := func(body, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// the requests that cannot be parsed are rejected without being charged
+	single := `{"jsonrpc":"2.0","id":7,"method":"debug_traceTransaction"}`
+	rec := do(single+"x", "")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), auth.ErrInvalidRequest.Error())
+	require.Equal(t, http.StatusBadRequest, do(`{"jsonrpc":"2.0","id":7,"method":`, "").Code)
+
+	require.Equal(t, http.StatusOK, do(single, "").Code)
+
+	rec = do(single, "")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	var res struct {
+		ID    int `json:"id"`
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, 7, res.ID)
+	require.Equal(t, ErrCodeLimitExceeded, res.Error.Code)
+	require.Equal(t, ErrMsgLimitExceeded, res.Error.Message)
+
+	rec = do(`[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_getLogs"}]`, "")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	var batch []json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &batch))
+	require.Len(t, batch, 2)
+
+	// requests forwarded by the WebSocket server are limited on their connection
+	require.Equal(t, http.StatusOK, do(single, internalToken).Code)
+}
@@ -61,9 +61,11 @@ type RPCStream struct {
 	logStream    *Stream[*ethtypes.Log]
 
 	// pendingTxStream is backed by check-tx ante handler
-	pendingTxStream *Stream[common.Hash]
+	pendingTxStream *Stream[*ethtypes.Transaction]
 
-	wg sync.WaitGroup
+	// initMu guards the lazy initialization of the subscriptions
+	initMu sync.Mutex
+	wg     sync.WaitGroup
 }
 
 func NewRPCStreams(evtClient rpcclient.EventsClient, logger log.Logger, txDecoder sdk.TxDecoder) *RPCStream {
@@ -71,11 +73,14 @@ func NewRPCStreams(evtClient rpcclient.EventsClient, logger log.Logger, txDecode
 		evtClient:       evtClient,
 		logger:          logger,
 		txDecoder:       txDecoder,
-		pendingTxStream: NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
+		pendingTxStream: NewStream[*ethtypes.Transaction](txStreamSegmentSize, txStreamCapacity),
 	}
 }
 
 func (s *RPCStream) initSubscriptions() {
+	s.initMu.Lock()
+	defer s.initMu.Unlock()
+
 	if s.headerStream != nil {
 		// already initialized
 		return
@@ -105,6 +110,9 @@ func (s *RPCStream) initSubscriptions() {
 }
 
 func (s *RPCStream) Close() error {
+	s.initMu.Lock()
+	defer s.initMu.Unlock()
+
 	if s.headerStream == nil {
 		// not initialized
 		return nil
@@ -122,7 +130,7 @@ func (s *RPCStream) HeaderStream() *Stream[RPCHeader] {
 	return s.headerStream
 }
 
-func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
+func (s *RPCStream) PendingTxStream() *Stream[*ethtypes.Transaction] {
 	return s.pendingTxStream
 }
 
@@ -132,8 +140,8 @@ func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 }
 
 // ListenPendingTx is a callback passed to application to listen for pending transactions in CheckTx.
-func (s *RPCStream) ListenPendingTx(hash common.Hash) {
-	s.PendingTxStream().Add(hash)
+func (s *RPCStream) ListenPendingTx(tx *ethtypes.Transaction) {
+	s.PendingTxStream().Add(tx)
 }
 
 func (s *RPCStream) start(
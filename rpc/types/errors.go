@@ -1,5 +1,30 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+// TxSyncTimeoutError is returned by eth_sendRawTransactionSync when the
+// transaction is not included in a block within the timeout, with the
+// transaction hash as error data as specified by EIP-7966.
+type TxSyncTimeoutError struct {
+	Hash common.Hash
+}
+
+func (e *TxSyncTimeoutError) Error() string {
+	return "the transaction was added to the mempool but wasn't processed within the designated timeout"
+}
+
+// ErrorCode returns the JSON error code of the timeout.
+func (e *TxSyncTimeoutError) ErrorCode() int {
+	return 4
+}
+
+// ErrorData returns the hash of the transaction.
+func (e *TxSyncTimeoutError) ErrorData() interface{} {
+	return e.Hash.Hex()
+}
@@ -0,0 +1,272 @@
+// Package proof verifies the commit level proofs returned by eth_getProof when
+// the full proof is requested, so that light clients and bridges can validate
+// the EVM state against the AppHash of a trusted CometBFT block header.
+//
+// Cosmos EVM doesn't keep the state in a Merkle Patricia Trie: each store of
+// the application multistore is an IAVL tree, and the AppHash committed in the
+// header of block H+1 is the root of the simple Merkle tree of the roots of
+// the stores after block H. The proof of a key in a store is therefore a list
+// of two CometBFT proof operations, each holding a marshaled ICS-23
+// CommitmentProof:
+//
+//   - an "ics23:iavl" operation, keyed by the store key, proving the existence
+//     of the value (or the absence of the key) in the IAVL tree of the store,
+//     and computing the root of the store;
+//   - an "ics23:simple" operation, keyed by the store name, proving the
+//     existence of the store root in the multistore, and computing the AppHash.
+//
+// An account is backed by the following store entries:
+//
+//   - the auth account at AccountKey in the "acc" store, a protobuf Any of the
+//     account, holding the nonce as the sequence;
+//   - the bank balance of the EVM denom at BalanceKey in the "bank" store;
+//   - when the EVM coin has less than 18 decimals, the fractional balance at
+//     FractionalBalanceKey in the "precisebank" store, absent when zero;
+//   - the code hash at CodeHashKey in the "evm" store, absent for accounts
+//     without code;
+//   - the storage slots at StateKey in the "evm" store, absent when zero.
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ErrNoCommitProof is returned when verifying an eth_getProof result without
+// the full commit proof.
+var ErrNoCommitProof = errors.New("account result has no commit proof")
+
+// AccountKey returns the key of the auth account of the address in the acc
+// store.
+func AccountKey(addr common.Address) []byte {
+	return append(bytes.Clone(authtypes.AddressStoreKeyPrefix), addr.Bytes()...)
+}
+
+// BalanceKey returns the key of the bank balance of the address in the bank
+// store.
+func BalanceKey(addr common.Address, denom string) []byte {
+	key := append(bytes.Clone(banktypes.BalancesPrefix.Bytes()), address.MustLengthPrefix(addr.Bytes())...)
+	return append(key, denom...)
+}
+
+// FractionalBalanceKey returns the key of the fractional balance of the
+// address in the precisebank store.
+func FractionalBalanceKey(addr common.Address) []byte {
+	return append(bytes.Clone(precisebanktypes.FractionalBalancePrefix), addr.Bytes()...)
+}
+
+// CodeHashKey returns the key of the code hash of the address in the evm
+// store.
+func CodeHashKey(addr common.Address) []byte {
+	return append(bytes.Clone(evmtypes.KeyPrefixCodeHash), addr.Bytes()...)
+}
+
+// FromProofOps converts the proof operations of an ABCI query response.
+func FromProofOps(proof *cmtcrypto.ProofOps) []rpctypes.ProofOp {
+	if proof == nil {
+		return nil
+	}
+	ops := make([]rpctypes.ProofOp, len(proof.Ops))
+	for i, op := range proof.Ops {
+		ops[i] = rpctypes.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+	return ops
+}
+
+// VerifyStoreProof verifies the value, or the absence if the value is empty,
+// of the key in the store against the AppHash.
+func VerifyStoreProof(p rpctypes.StoreProof, appHash []byte) error {
+	ops := &cmtcrypto.ProofOps{Ops: make([]cmtcrypto.ProofOp, len(p.Ops))}
+	for i, op := range p.Ops {
+		ops.Ops[i] = cmtcrypto.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(p.Store), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(ops, appHash, keyPath)
+	}
+	return prt.VerifyValue(ops, appHash, keyPath, p.Value)
+}
+
+// VerifyAccountResult verifies the commit proof of an eth_getProof result
+// against the AppHash of the CometBFT block header at the commit proof
+// height, which must be obtained from a trusted source such as a light
+// client. It checks that the store proofs are valid and that they prove the
+// nonce, the balance, the code hash and the storage values of the result.
+//
+// The balance is checked in the EVM decimals of the configured EVM coin. As
+// it is the spendable balance, which excludes the vesting coins locked at
+// the block time, the balance of a vesting account is only checked to be
+// within the proven balance minus its original vesting coins and the proven
+// balance.
+func VerifyAccountResult(res *rpctypes.AccountResult, appHash []byte) error {
+	cp := res.CommitProof
+	if cp == nil {
+		return ErrNoCommitProof
+	}
+	if !bytes.Equal(cp.AppHash, appHash) {
+		return fmt.Errorf("commit proof app hash %X doesn't match %X", []byte(cp.AppHash), appHash)
+	}
+
+	if err := verifyEntry("account", cp.Account, authtypes.StoreKey, AccountKey(res.Address), appHash); err != nil {
+		return err
+	}
+	account, err := decodeAccount(cp.Account.Value)
+	if err != nil {
+		return err
+	}
+	var nonce uint64
+	if account != nil {
+		nonce = account.GetSequence()
+	}
+	if nonce != uint64(res.Nonce) {
+		return fmt.Errorf("proven nonce %d doesn't match %d", nonce, uint64(res.Nonce))
+	}
+
+	if err := verifyBalance(res, account, appHash); err != nil {
+		return err
+	}
+
+	if err := verifyEntry("code hash", cp.CodeHash, evmtypes.StoreKey, CodeHashKey(res.Address), appHash); err != nil {
+		return err
+	}
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(cp.CodeHash.Value) > 0 {
+		codeHash = common.BytesToHash(cp.CodeHash.Value)
+	}
+	if codeHash != res.CodeHash {
+		return fmt.Errorf("proven code hash %s doesn't match %s", codeHash, res.CodeHash)
+	}
+
+	if len(cp.Storage) != len(res.StorageProof) {
+		return fmt.Errorf("expected %d storage proofs, got %d", len(res.StorageProof), len(cp.Storage))
+	}
+	for i, storage := range res.StorageProof {
+		slot := common.HexToHash(storage.Key)
+		key := evmtypes.StateKey(res.Address, slot.Bytes())
+		if err := verifyEntry("storage", cp.Storage[i], evmtypes.StoreKey, key, appHash); err != nil {
+			return err
+		}
+		value := new(big.Int)
+		if storage.Value != nil {
+			value = storage.Value.ToInt()
+		}
+		if new(big.Int).SetBytes(cp.Storage[i].Value).Cmp(value) != 0 {
+			return fmt.Errorf("proven value of storage slot %s doesn't match %s", slot, value)
+		}
+	}
+	return nil
+}
+
+// verifyEntry checks that the proof is the one of the key in the store and
+// verifies it.
+func verifyEntry(name string, p rpctypes.StoreProof, store string, key []byte, appHash []byte) error {
+	if p.Store != store || !bytes.Equal(p.Key, key) {
+		return fmt.Errorf("%s proof is for key %X of store %s, expected key %X of store %s", name, []byte(p.Key), p.Store, key, store)
+	}
+	if err := VerifyStoreProof(p, appHash); err != nil {
+		return fmt.Errorf("invalid %s proof: %w", name, err)
+	}
+	return nil
+}
+
+// verifyBalance verifies the proofs of the bank and fractional balances of
+// the address and checks the balance of the result against them.
+func verifyBalance(res *rpctypes.AccountResult, account sdk.AccountI, appHash []byte) error {
+	cp := res.CommitProof
+	denom := evmtypes.GetEVMCoinDenom()
+	if err := verifyEntry("balance", cp.Balance, banktypes.StoreKey, BalanceKey(res.Address, denom), appHash); err != nil {
+		return err
+	}
+	integer, err := decodeInt("balance", cp.Balance.Value)
+	if err != nil {
+		return err
+	}
+
+	decimals := evmtypes.GetEVMCoinDecimals()
+	fractional := sdkmath.ZeroInt()
+	if decimals != evmtypes.EighteenDecimals {
+		key := FractionalBalanceKey(res.Address)
+		if err := verifyEntry("fractional balance", cp.FractionalBalance, precisebanktypes.StoreKey, key, appHash); err != nil {
+			return err
+		}
+		if fractional, err = decodeInt("fractional balance", cp.FractionalBalance.Value); err != nil {
+			return err
+		}
+	}
+
+	factor := decimals.ConversionFactor()
+	proven := integer.Mul(factor).Add(fractional)
+	balance := sdkmath.ZeroInt()
+	if res.Balance != nil {
+		balance = sdkmath.NewIntFromBigInt(res.Balance.ToInt())
+	}
+
+	vesting, ok := account.(vestingexported.VestingAccount)
+	if !ok {
+		if !balance.Equal(proven) {
+			return fmt.Errorf("proven balance %s doesn't match %s", proven, balance)
+		}
+		return nil
+	}
+	lowest := proven.Sub(vesting.GetOriginalVesting().AmountOf(denom).Mul(factor))
+	if balance.GT(proven) || balance.LT(lowest) {
+		return fmt.Errorf("balance %s of the vesting account isn't between %s and the proven balance %s", balance, lowest, proven)
+	}
+	return nil
+}
+
+// decodeAccount decodes an auth account, nil if absent.
+func decodeAccount(bz []byte) (sdk.AccountI, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+
+	var account sdk.AccountI
+	if err := codec.NewProtoCodec(registry).UnmarshalInterface(bz, &account); err != nil {
+		return nil, fmt.Errorf("failed to decode the proven account: %w", err)
+	}
+	return account, nil
+}
+
+// decodeInt decodes a proven amount, zero if absent.
+func decodeInt(name string, bz []byte) (sdkmath.Int, error) {
+	amount := sdkmath.ZeroInt()
+	if len(bz) == 0 {
+		return amount, nil
+	}
+	if err := amount.Unmarshal(bz); err != nil {
+		return amount, fmt.Errorf("failed to decode the proven %s: %w", name, err)
+	}
+	return amount, nil
+}
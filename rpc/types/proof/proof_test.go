@@ -0,0 +1,218 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testState is a committed multistore with the entries of an account.
+type testState struct {
+	store   *rootmulti.Store
+	version int64
+	appHash []byte
+}
+
+func (s testState) storeProof(t *testing.T, store string, key []byte) rpctypes.StoreProof {
+	t.Helper()
+	res, err := s.store.Query(&storetypes.RequestQuery{
+		Path:   "/" + store + "/key",
+		Data:   key,
+		Height: s.version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	return rpctypes.StoreProof{Store: store, Key: key, Value: res.Value, Ops: FromProofOps(res.ProofOps)}
+}
+
+// newTestState commits the account, a balance of 1uatom and 5aatom, the code
+// hash and the storage slot of the address, the EVM coin having 6 decimals.
+func newTestState(t *testing.T, addr common.Address, account sdk.AccountI, slot, value, codeHash common.Hash) testState {
+	t.Helper()
+	evmtypes.SetDefaultEvmCoinInfo(evmtypes.EvmCoinInfo{
+		Denom:         "uatom",
+		ExtendedDenom: "aatom",
+		DisplayDenom:  "atom",
+		Decimals:      evmtypes.SixDecimals.Uint32(),
+	})
+
+	keys := map[string]*storetypes.KVStoreKey{}
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, name := range []string{authtypes.StoreKey, banktypes.StoreKey, precisebanktypes.StoreKey, evmtypes.StoreKey} {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		store.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	accountBz, err := codec.NewProtoCodec(registry).MarshalInterface(account)
+	require.NoError(t, err)
+
+	store.GetKVStore(keys[authtypes.StoreKey]).Set(AccountKey(addr), accountBz)
+	store.GetKVStore(keys[banktypes.StoreKey]).Set(BalanceKey(addr, "uatom"), marshalInt(t, 1))
+	store.GetKVStore(keys[precisebanktypes.StoreKey]).Set(FractionalBalanceKey(addr), marshalInt(t, 5))
+	store.GetKVStore(keys[evmtypes.StoreKey]).Set(CodeHashKey(addr), codeHash.Bytes())
+	store.GetKVStore(keys[evmtypes.StoreKey]).Set(evmtypes.StateKey(addr, slot.Bytes()), value.Bytes())
+	commitID := store.Commit()
+
+	return testState{store: store, version: commitID.Version, appHash: commitID.Hash}
+}
+
+func marshalInt(t *testing.T, amount int64) []byte {
+	t.Helper()
+	bz, err := sdkmath.NewInt(amount).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func newAccountResult(t *testing.T, state testState, addr common.Address, balance int64) *rpctypes.AccountResult {
+	t.Helper()
+	return &rpctypes.AccountResult{
+		Address:  addr,
+		Balance:  (*hexutil.Big)(big.NewInt(balance)),
+		CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash),
+		CommitProof: &rpctypes.CommitProof{
+			Height:            hexutil.Uint64(state.version + 1),
+			AppHash:           state.appHash,
+			Account:           state.storeProof(t, authtypes.StoreKey, AccountKey(addr)),
+			Balance:           state.storeProof(t, banktypes.StoreKey, BalanceKey(addr, "uatom")),
+			FractionalBalance: state.storeProof(t, precisebanktypes.StoreKey, FractionalBalanceKey(addr)),
+			CodeHash:          state.storeProof(t, evmtypes.StoreKey, CodeHashKey(addr)),
+		},
+	}
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot := common.HexToHash("0x01")
+	emptySlot := common.HexToHash("0x02")
+	value := common.HexToHash("0x2a")
+	codeHash := common.HexToHash("0xc0de")
+	state := newTestState(t, addr, authtypes.NewBaseAccount(addr.Bytes(), nil, 1, 3), slot, value, codeHash)
+
+	newResult := func() *rpctypes.AccountResult {
+		res := newAccountResult(t, state, addr, 1_000_000_000_005)
+		res.CodeHash = codeHash
+		res.Nonce = 3
+		res.StorageProof = []rpctypes.StorageResult{
+			{Key: slot.Hex(), Value: (*hexutil.Big)(value.Big())},
+			{Key: emptySlot.Hex(), Value: (*hexutil.Big)(new(big.Int))},
+		}
+		res.CommitProof.Storage = []rpctypes.StoreProof{
+			state.storeProof(t, evmtypes.StoreKey, evmtypes.StateKey(addr, slot.Bytes())),
+			state.storeProof(t, evmtypes.StoreKey, evmtypes.StateKey(addr, emptySlot.Bytes())),
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(res *rpctypes.AccountResult)
+		expErr   string
+	}{
+		{"valid", func(*rpctypes.AccountResult) {}, ""},
+		{"no commit proof", func(res *rpctypes.AccountResult) { res.CommitProof = nil }, ErrNoCommitProof.Error()},
+		{"wrong nonce", func(res *rpctypes.AccountResult) { res.Nonce = 4 }, "proven nonce 3 doesn't match 4"},
+		{"wrong balance", func(res *rpctypes.AccountResult) {
+			res.Balance = (*hexutil.Big)(big.NewInt(1_000_000_000_000))
+		}, "proven balance 1000000000005 doesn't match 1000000000000"},
+		{"tampered balance", func(res *rpctypes.AccountResult) {
+			res.Balance = (*hexutil.Big)(big.NewInt(2_000_000_000_005))
+			res.CommitProof.Balance.Value = marshalInt(t, 2)
+		}, "invalid balance proof"},
+		{"tampered fractional balance", func(res *rpctypes.AccountResult) {
+			res.Balance = (*hexutil.Big)(big.NewInt(1_000_000_000_006))
+			res.CommitProof.FractionalBalance.Value = marshalInt(t, 6)
+		}, "invalid fractional balance proof"},
+		{"balance of another denom", func(res *rpctypes.AccountResult) {
+			res.CommitProof.Balance = state.storeProof(t, banktypes.StoreKey, BalanceKey(addr, "stake"))
+		}, "balance proof is for key"},
+		{"missing fractional balance proof", func(res *rpctypes.AccountResult) {
+			res.CommitProof.FractionalBalance = rpctypes.StoreProof{}
+		}, "fractional balance proof is for key"},
+		{"wrong code hash", func(res *rpctypes.AccountResult) { res.CodeHash = common.Hash{} }, "proven code hash"},
+		{"wrong storage value", func(res *rpctypes.AccountResult) {
+			res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(1))
+		}, "proven value of storage slot"},
+		{"tampered storage value", func(res *rpctypes.AccountResult) {
+			res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(1))
+			res.CommitProof.Storage[0].Value = common.BigToHash(big.NewInt(1)).Bytes()
+		}, "invalid storage proof"},
+		{"absent storage claimed present", func(res *rpctypes.AccountResult) {
+			res.StorageProof[1].Value = (*hexutil.Big)(big.NewInt(1))
+			res.CommitProof.Storage[1].Value = common.BigToHash(big.NewInt(1)).Bytes()
+		}, "invalid storage proof"},
+		{"proof of another key", func(res *rpctypes.AccountResult) {
+			res.CommitProof.Storage[0], res.CommitProof.Storage[1] = res.CommitProof.Storage[1], res.CommitProof.Storage[0]
+		}, "storage proof is for key"},
+		{"missing storage proof", func(res *rpctypes.AccountResult) {
+			res.CommitProof.Storage = res.CommitProof.Storage[:1]
+		}, "expected 2 storage proofs, got 1"},
+		{"wrong app hash", func(res *rpctypes.AccountResult) { res.CommitProof.AppHash = make([]byte, 32) }, "doesn't match"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := newResult()
+			tc.malleate(res)
+			err := VerifyAccountResult(res, state.appHash)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestVerifyVestingAccountBalance(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	base := authtypes.NewBaseAccount(addr.Bytes(), nil, 1, 0)
+	account, err := vestingtypes.NewContinuousVestingAccount(base, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), 0, 100)
+	require.NoError(t, err)
+	state := newTestState(t, addr, account, common.Hash{1}, common.Hash{}, common.BytesToHash(evmtypes.EmptyCodeHash))
+
+	// the locked coins depend on the block time, so the spendable balance is
+	// between the proven balance minus the original vesting and the proven
+	// balance
+	for _, balance := range []int64{5, 1_000_000_000_005} {
+		require.NoError(t, VerifyAccountResult(newAccountResult(t, state, addr, balance), state.appHash))
+	}
+	for _, balance := range []int64{4, 1_000_000_000_006} {
+		err := VerifyAccountResult(newAccountResult(t, state, addr, balance), state.appHash)
+		require.ErrorContains(t, err, "of the vesting account isn't between 5 and the proven balance 1000000000005")
+	}
+}
+
+func TestVerifyStoreProofWrongRoot(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	state := newTestState(t, addr, authtypes.NewBaseAccount(addr.Bytes(), nil, 1, 0), common.Hash{1}, common.Hash{2}, common.Hash{3})
+
+	p := state.storeProof(t, authtypes.StoreKey, AccountKey(addr))
+	require.NoError(t, VerifyStoreProof(p, state.appHash))
+	require.Error(t, VerifyStoreProof(p, make([]byte, 32)))
+}
@@ -6,29 +6,41 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Bank, staking and ERC20 module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Bank      banktypes.QueryClient
+	Staking   stakingtypes.QueryClient
+	Erc20     erc20types.QueryClient
 }
 
-// NewQueryClient creates a new gRPC query client
-func NewQueryClient(clientCtx client.Context) *QueryClient {
+// NewQueryClient creates a new gRPC query client from a connection, such as
+// the client context
+func NewQueryClient(conn gogogrpc.ClientConn) *QueryClient {
 	return &QueryClient{
-		ServiceClient: tx.NewServiceClient(clientCtx),
-		QueryClient:   evmtypes.NewQueryClient(clientCtx),
-		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		ServiceClient: tx.NewServiceClient(conn),
+		QueryClient:   evmtypes.NewQueryClient(conn),
+		FeeMarket:     feemarkettypes.NewQueryClient(conn),
+		Bank:          banktypes.NewQueryClient(conn),
+		Staking:       stakingtypes.NewQueryClient(conn),
+		Erc20:         erc20types.NewQueryClient(conn),
 	}
 }
 
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks, including the gap
+	// filling empty blocks, that can be simulated in a single eth_simulateV1
+	// request.
+	MaxSimulateBlocks = 256
+
+	// MaxSimulateCalls is the maximum number of calls, across all the blocks,
+	// that can be simulated in a single eth_simulateV1 request.
+	MaxSimulateCalls = 1000
+
+	// SimulateTimestampIncrement is the default timestamp increment between
+	// two simulated blocks.
+	SimulateTimestampIncrement = 12
+)
+
+// Error codes of the eth_simulateV1 call results, as defined by the
+// execution-apis specification.
+const (
+	SimulateErrCodeReverted = 3
+	SimulateErrCodeVMError  = -32015
+)
+
+// SimulateTransferAddress is the address emitting the synthetic ERC20 like
+// Transfer logs of the native coin transfers when traceTransfers is enabled.
+var SimulateTransferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially within a single
+// block, on top of the given state and block overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is the set of header fields to override in a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	LogsBloom     ethtypes.Bloom  `json:"logsBloom"`
+	Transactions  []interface{}   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SanitizeBlocks returns the blocks to simulate on top of the base block with
+// the given number and timestamp. Missing block numbers and timestamps are
+// filled in, and gaps between the requested block numbers are filled with
+// empty blocks. Block numbers and timestamps must be strictly increasing, and
+// the blocks can hold at most MaxSimulateCalls calls.
+func (opts *SimOpts) SanitizeBlocks(baseNumber, baseTime uint64) ([]SimBlock, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, fmt.Errorf("empty input")
+	}
+
+	calls := 0
+	for _, block := range opts.BlockStateCalls {
+		calls += len(block.Calls)
+	}
+	if calls > MaxSimulateCalls {
+		return nil, fmt.Errorf("too many calls: the maximum is %d", MaxSimulateCalls)
+	}
+
+	var (
+		blocks     = make([]SimBlock, 0, len(opts.BlockStateCalls))
+		prevNumber = baseNumber
+		prevTime   = baseTime
+	)
+
+	for _, block := range opts.BlockStateCalls {
+		overrides := BlockOverrides{}
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		number := prevNumber + 1
+		if overrides.Number != nil {
+			n := overrides.Number.ToInt()
+			if !n.IsUint64() || n.Uint64() <= prevNumber {
+				return nil, fmt.Errorf("block numbers must be in order: %s <= %d", n, prevNumber)
+			}
+			number = n.Uint64()
+		}
+
+		if number-baseNumber > MaxSimulateBlocks {
+			return nil, fmt.Errorf("too many blocks: the maximum is %d", MaxSimulateBlocks)
+		}
+
+		// fill the gap with empty blocks
+		for n := prevNumber + 1; n < number; n++ {
+			prevTime += SimulateTimestampIncrement
+			gapTime := prevTime
+			blocks = append(blocks, SimBlock{
+				BlockOverrides: &BlockOverrides{
+					Number: (*hexutil.Big)(new(big.Int).SetUint64(n)),
+					Time:   (*hexutil.Uint64)(&gapTime),
+				},
+			})
+		}
+
+		timestamp := prevTime + SimulateTimestampIncrement
+		if overrides.Time != nil {
+			if uint64(*overrides.Time) <= prevTime {
+				return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*overrides.Time), prevTime)
+			}
+			timestamp = uint64(*overrides.Time)
+		}
+
+		overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(number))
+		overrides.Time = (*hexutil.Uint64)(&timestamp)
+		block.BlockOverrides = &overrides
+		blocks = append(blocks, block)
+
+		prevNumber = number
+		prevTime = timestamp
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestSimOpts_SanitizeBlocks(t *testing.T) {
+	number := func(n int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(n)) }
+	timestamp := func(ts uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&ts) }
+
+	testCases := []struct {
+		name      string
+		blocks    []rpc.SimBlock
+		expNumber []uint64
+		expTime   []uint64
+		errorMsg  string
+	}{
+		{
+			name:     "empty input",
+			errorMsg: "empty input",
+		},
+		{
+			name:      "defaults",
+			blocks:    []rpc.SimBlock{{}, {}},
+			expNumber: []uint64{11, 12},
+			expTime:   []uint64{1012, 1024},
+		},
+		{
+			name: "gap filled with empty blocks",
+			blocks: []rpc.SimBlock{
+				{BlockOverrides: &rpc.BlockOverrides{Number: number(13)}},
+				{BlockOverrides: &rpc.BlockOverrides{Time: timestamp(2000)}},
+			},
+			expNumber: []uint64{11, 12, 13, 14},
+			expTime:   []uint64{1012, 1024, 1036, 2000},
+		},
+		{
+			name: "block numbers out of order",
+			blocks: []rpc.SimBlock{
+				{BlockOverrides: &rpc.BlockOverrides{Number: number(12)}},
+				{BlockOverrides: &rpc.BlockOverrides{Number: number(12)}},
+			},
+			errorMsg: "block numbers must be in order",
+		},
+		{
+			name: "timestamps out of order",
+			blocks: []rpc.SimBlock{
+				{BlockOverrides: &rpc.BlockOverrides{Time: timestamp(1000)}},
+			},
+			errorMsg: "block timestamps must be in order",
+		},
+		{
+			name: "too many blocks",
+			blocks: []rpc.SimBlock{
+				{BlockOverrides: &rpc.BlockOverrides{Number: number(10 + rpc.MaxSimulateBlocks + 1)}},
+			},
+			errorMsg: "too many blocks",
+		},
+		{
+			name: "too many calls",
+			blocks: []rpc.SimBlock{
+				{Calls: make([]evmtypes.TransactionArgs, rpc.MaxSimulateCalls)},
+				{Calls: make([]evmtypes.TransactionArgs, 1)},
+			},
+			errorMsg: "too many calls",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := rpc.SimOpts{BlockStateCalls: tc.blocks}
+			blocks, err := opts.SanitizeBlocks(10, 1000)
+			if tc.errorMsg != "" {
+				require.ErrorContains(t, err, tc.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, blocks, len(tc.expNumber))
+			for i, block := range blocks {
+				require.Equal(t, tc.expNumber[i], block.BlockOverrides.Number.ToInt().Uint64())
+				require.Equal(t, tc.expTime[i], uint64(*block.BlockOverrides.Time))
+			}
+		})
+	}
+}
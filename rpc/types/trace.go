@@ -0,0 +1,245 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Parity trace types accepted by trace_replayBlockTransactions.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// TraceFilterArgs are the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// FlatTrace is a Parity style flat call trace, as produced by the
+// flatCallTracer.
+type FlatTrace struct {
+	Action              FlatTraceAction  `json:"action"`
+	BlockHash           *common.Hash     `json:"blockHash,omitempty"`
+	BlockNumber         *uint64          `json:"blockNumber,omitempty"`
+	Error               string           `json:"error,omitempty"`
+	Result              *FlatTraceResult `json:"result,omitempty"`
+	Subtraces           int              `json:"subtraces"`
+	TraceAddress        []int            `json:"traceAddress"`
+	TransactionHash     *common.Hash     `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64          `json:"transactionPosition,omitempty"`
+	Type                string           `json:"type"`
+}
+
+// FlatTraceAction is the action of a FlatTrace.
+type FlatTraceAction struct {
+	Author         *common.Address `json:"author,omitempty"`
+	RewardType     string          `json:"rewardType,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+}
+
+// FlatTraceResult is the result of a FlatTrace.
+type FlatTraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// Sender returns the address initiating the traced action.
+func (t *FlatTrace) Sender() *common.Address {
+	if t.Type == "suicide" {
+		return t.Action.Address
+	}
+	return t.Action.From
+}
+
+// Recipient returns the address receiving the traced action. For contract
+// creations it is the address of the created contract.
+func (t *FlatTrace) Recipient() *common.Address {
+	switch t.Type {
+	case "create":
+		if t.Result != nil {
+			return t.Result.Address
+		}
+		return nil
+	case "suicide":
+		return t.Action.RefundAddress
+	default:
+		return t.Action.To
+	}
+}
+
+// Output returns the return data of the traced action, or the deployed code
+// for contract creations.
+func (t *FlatTrace) Output() hexutil.Bytes {
+	switch {
+	case t.Result == nil:
+		return hexutil.Bytes{}
+	case t.Result.Output != nil:
+		return *t.Result.Output
+	case t.Result.Code != nil:
+		return *t.Result.Code
+	default:
+		return hexutil.Bytes{}
+	}
+}
+
+// TraceResults is the result of replaying a transaction with
+// trace_replayBlockTransactions. Only the requested trace types are set.
+type TraceResults struct {
+	Output          hexutil.Bytes   `json:"output"`
+	StateDiff       StateDiff       `json:"stateDiff"`
+	Trace           []*FlatTrace    `json:"trace"`
+	VMTrace         json.RawMessage `json:"vmTrace"`
+	TransactionHash common.Hash     `json:"transactionHash"`
+}
+
+// StateDiff is the Parity style set of account changes of a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff holds the changes of a single account.
+type AccountDiff struct {
+	Balance Diff                 `json:"balance"`
+	Code    Diff                 `json:"code"`
+	Nonce   Diff                 `json:"nonce"`
+	Storage map[common.Hash]Diff `json:"storage"`
+}
+
+// Diff is the change of a single value. A nil From denotes a created value,
+// a nil To a removed value, and both nil an unchanged value.
+type Diff struct {
+	From interface{}
+	To   interface{}
+}
+
+// MarshalJSON encodes the diff in the Parity format: "=", {"+": to},
+// {"-": from} or {"*": {"from": from, "to": to}}.
+func (d Diff) MarshalJSON() ([]byte, error) {
+	switch {
+	case d.From == nil && d.To == nil:
+		return json.Marshal("=")
+	case d.From == nil:
+		return json.Marshal(map[string]interface{}{"+": d.To})
+	case d.To == nil:
+		return json.Marshal(map[string]interface{}{"-": d.From})
+	default:
+		return json.Marshal(map[string]interface{}{
+			"*": map[string]interface{}{"from": d.From, "to": d.To},
+		})
+	}
+}
+
+// PrestateAccount is an account of the prestateTracer output.
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// PrestateDiff is the output of the prestateTracer in diff mode. Post only
+// holds the modified fields of the accounts, created accounts are missing
+// from Pre and deleted accounts are missing from Post.
+type PrestateDiff struct {
+	Pre  map[common.Address]*PrestateAccount `json:"pre"`
+	Post map[common.Address]*PrestateAccount `json:"post"`
+}
+
+// NewStateDiff converts the prestateTracer diff mode output to a Parity
+// style StateDiff.
+func NewStateDiff(diff *PrestateDiff) StateDiff {
+	stateDiff := make(StateDiff)
+
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			// the account was deleted
+			accDiff := &AccountDiff{
+				Balance: Diff{From: balanceOrZero(pre.Balance)},
+				Code:    Diff{From: codeOrEmpty(pre.Code)},
+				Nonce:   Diff{From: hexutil.Uint64(pre.Nonce)},
+				Storage: make(map[common.Hash]Diff, len(pre.Storage)),
+			}
+			for key, val := range pre.Storage {
+				accDiff.Storage[key] = Diff{From: val}
+			}
+			stateDiff[addr] = accDiff
+			continue
+		}
+
+		accDiff := &AccountDiff{Storage: make(map[common.Hash]Diff)}
+		if post.Balance != nil && post.Balance.ToInt().Cmp(balanceOrZero(pre.Balance).ToInt()) != 0 {
+			accDiff.Balance = Diff{From: balanceOrZero(pre.Balance), To: post.Balance}
+		}
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			accDiff.Nonce = Diff{From: hexutil.Uint64(pre.Nonce), To: hexutil.Uint64(post.Nonce)}
+		}
+		if post.Code != nil && !bytes.Equal(post.Code, pre.Code) {
+			accDiff.Code = Diff{From: codeOrEmpty(pre.Code), To: post.Code}
+		}
+		for key, val := range post.Storage {
+			accDiff.Storage[key] = Diff{From: pre.Storage[key], To: val}
+		}
+		for key, val := range pre.Storage {
+			if _, ok := post.Storage[key]; !ok {
+				// the slot was cleared
+				accDiff.Storage[key] = Diff{From: val, To: common.Hash{}}
+			}
+		}
+		stateDiff[addr] = accDiff
+	}
+
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; ok {
+			continue
+		}
+		// the account was created
+		accDiff := &AccountDiff{
+			Balance: Diff{To: balanceOrZero(post.Balance)},
+			Code:    Diff{To: codeOrEmpty(post.Code)},
+			Nonce:   Diff{To: hexutil.Uint64(post.Nonce)},
+			Storage: make(map[common.Hash]Diff, len(post.Storage)),
+		}
+		for key, val := range post.Storage {
+			accDiff.Storage[key] = Diff{To: val}
+		}
+		stateDiff[addr] = accDiff
+	}
+
+	return stateDiff
+}
+
+func balanceOrZero(balance *hexutil.Big) *hexutil.Big {
+	if balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return balance
+}
+
+func codeOrEmpty(code hexutil.Bytes) hexutil.Bytes {
+	if code == nil {
+		return hexutil.Bytes{}
+	}
+	return code
+}
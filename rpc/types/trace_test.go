@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/cosmos/evm/rpc/types"
+)
+
+func TestDiff_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name string
+		diff rpc.Diff
+		exp  string
+	}{
+		{"unchanged", rpc.Diff{}, `"="`},
+		{"born", rpc.Diff{To: hexutil.Uint64(1)}, `{"+":"0x1"}`},
+		{"died", rpc.Diff{From: hexutil.Uint64(1)}, `{"-":"0x1"}`},
+		{"changed", rpc.Diff{From: hexutil.Uint64(1), To: hexutil.Uint64(2)}, `{"*":{"from":"0x1","to":"0x2"}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.diff)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.exp, string(bz))
+		})
+	}
+}
+
+func TestNewStateDiff(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1")
+		contract = common.HexToAddress("0x2")
+		created  = common.HexToAddress("0x3")
+		deleted  = common.HexToAddress("0x4")
+		slot1    = common.HexToHash("0x1")
+		slot2    = common.HexToHash("0x2")
+	)
+	balance := func(n int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(n)) }
+
+	diff := &rpc.PrestateDiff{
+		Pre: map[common.Address]*rpc.PrestateAccount{
+			sender: {Balance: balance(100), Nonce: 1},
+			contract: {
+				Balance: balance(0),
+				Code:    hexutil.Bytes{0x60, 0x00},
+				Nonce:   1,
+				Storage: map[common.Hash]common.Hash{slot1: common.HexToHash("0xa"), slot2: common.HexToHash("0xb")},
+			},
+			deleted: {Balance: balance(5), Code: hexutil.Bytes{0x00}, Nonce: 1},
+		},
+		Post: map[common.Address]*rpc.PrestateAccount{
+			sender:   {Balance: balance(90), Nonce: 2},
+			contract: {Storage: map[common.Hash]common.Hash{slot1: common.HexToHash("0xc")}},
+			created:  {Balance: balance(10), Code: hexutil.Bytes{0x01}, Nonce: 1},
+		},
+	}
+
+	stateDiff := rpc.NewStateDiff(diff)
+	require.Len(t, stateDiff, 4)
+
+	require.Equal(t, rpc.Diff{From: balance(100), To: balance(90)}, stateDiff[sender].Balance)
+	require.Equal(t, rpc.Diff{From: hexutil.Uint64(1), To: hexutil.Uint64(2)}, stateDiff[sender].Nonce)
+	require.Equal(t, rpc.Diff{}, stateDiff[sender].Code)
+	require.Empty(t, stateDiff[sender].Storage)
+
+	require.Equal(t, rpc.Diff{}, stateDiff[contract].Balance)
+	require.Equal(t, rpc.Diff{}, stateDiff[contract].Nonce)
+	require.Equal(t, rpc.Diff{}, stateDiff[contract].Code)
+	require.Equal(t, map[common.Hash]rpc.Diff{
+		slot1: {From: common.HexToHash("0xa"), To: common.HexToHash("0xc")},
+		slot2: {From: common.HexToHash("0xb"), To: common.Hash{}},
+	}, stateDiff[contract].Storage)
+
+	require.Equal(t, rpc.Diff{To: balance(10)}, stateDiff[created].Balance)
+	require.Equal(t, rpc.Diff{To: hexutil.Bytes{0x01}}, stateDiff[created].Code)
+	require.Equal(t, rpc.Diff{To: hexutil.Uint64(1)}, stateDiff[created].Nonce)
+
+	require.Equal(t, rpc.Diff{From: balance(5)}, stateDiff[deleted].Balance)
+	require.Equal(t, rpc.Diff{From: hexutil.Bytes{0x00}}, stateDiff[deleted].Code)
+	require.Equal(t, rpc.Diff{From: hexutil.Uint64(1)}, stateDiff[deleted].Nonce)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
@@ -28,6 +30,39 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// CommitProof is only set when the full commit proof is requested.
+	CommitProof *CommitProof `json:"commitProof,omitempty"`
+}
+
+// CommitProof is the commit level proof of an eth_getProof result. It holds
+// the store entries backing the account, with the proofs chaining them to the
+// AppHash of the CometBFT block committing the queried state, which is the
+// block following the queried one. See the rpc/types/proof package.
+type CommitProof struct {
+	Height            hexutil.Uint64 `json:"height"`
+	AppHash           hexutil.Bytes  `json:"appHash"`
+	Account           StoreProof     `json:"account"`
+	Balance           StoreProof     `json:"balance"`
+	FractionalBalance StoreProof     `json:"fractionalBalance"`
+	CodeHash          StoreProof     `json:"codeHash"`
+	Storage           []StoreProof   `json:"storage"`
+}
+
+// StoreProof is the proof of the value of a key in a store of the multistore,
+// an empty value meaning the key is absent from the store.
+type StoreProof struct {
+	Store string        `json:"store"`
+	Key   hexutil.Bytes `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+	Ops   []ProofOp     `json:"proofOps"`
+}
+
+// ProofOp is a CometBFT proof operation, whose data is an ICS-23 commitment
+// proof.
+type ProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -62,6 +97,7 @@ type RPCTransaction struct {
 	R                   *hexutil.Big                    `json:"r"`
 	S                   *hexutil.Big                    `json:"s"`
 	YParity             *hexutil.Uint64                 `json:"yParity,omitempty"`
+	CosmosTxHash        *common.Hash                    `json:"cosmosTxHash,omitempty"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -184,8 +220,292 @@ type AccessListResult struct {
 	Error      string               `json:"error,omitempty"`
 }
 
+// CodeMetadataResult represents the verified deployment metadata of a contract
+// code, with the deployer and the creation transaction of the contract
+type CodeMetadataResult struct {
+	CodeHash         common.Hash     `json:"codeHash"`
+	CompilerVersion  string          `json:"compilerVersion,omitempty"`
+	ABI              json.RawMessage `json:"abi,omitempty"`
+	SourceHash       *common.Hash    `json:"sourceHash,omitempty"`
+	VerifiedContract *common.Address `json:"verifiedContract,omitempty"`
+	CreationVerified bool            `json:"creationVerified"`
+	Deployer         *common.Address `json:"deployer,omitempty"`
+	CreationTxHash   *common.Hash    `json:"creationTxHash,omitempty"`
+	Submitter        string          `json:"submitter"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+}
+
+// NewCodeMetadataResult returns the RPC representation of the given code
+// metadata. The deployer and the creation transaction are the ones of the
+// given creation record of the contract, if any.
+func NewCodeMetadataResult(metadata evmtypes.CodeMetadata, creation *evmtypes.ContractCreation) *CodeMetadataResult {
+	res := &CodeMetadataResult{
+		CodeHash:         common.HexToHash(metadata.CodeHash),
+		CompilerVersion:  metadata.CompilerVersion,
+		CreationVerified: metadata.CreationVerified,
+		Submitter:        metadata.Submitter,
+		BlockNumber:      hexutil.Uint64(metadata.Height), // #nosec G115
+	}
+	if metadata.Abi != "" {
+		res.ABI = json.RawMessage(metadata.Abi)
+	}
+	if metadata.SourceHash != "" {
+		sourceHash := common.HexToHash(metadata.SourceHash)
+		res.SourceHash = &sourceHash
+	}
+	if metadata.ContractAddress != "" {
+		contract := common.HexToAddress(metadata.ContractAddress)
+		res.VerifiedContract = &contract
+	}
+	if creation != nil {
+		deployer := common.HexToAddress(creation.Creator)
+		txHash := common.HexToHash(creation.TxHash)
+		res.Deployer, res.CreationTxHash = &deployer, &txHash
+	}
+	return res
+}
+
+// ContractCreatorResult represents the creator and the creation transaction of
+// a contract, as returned by ots_getContractCreator
+type ContractCreatorResult struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// Otterscan internal operation types.
+const (
+	OtsOpTransfer     = 0
+	OtsOpSelfDestruct = 1
+	OtsOpCreate       = 2
+	OtsOpCreate2      = 3
+)
+
+// InternalOperation represents an ETH transfer, contract creation or self
+// destruct happening inside a transaction, as returned by
+// ots_getInternalOperations
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// CosmosTxType is the type of the pseudo transactions synthesized for the
+// balance changes made by Cosmos transactions, the type of the deposit
+// transactions of the OP Stack, which are neither signed nor paying gas.
+const CosmosTxType = 0x7e
+
+// CosmosPseudoTx is a pseudo transaction synthesized for a balance change of
+// the EVM coin made by a Cosmos transaction, moving Value from From to To. The
+// zero address stands for the coins minted, burnt or otherwise not accounted
+// to an account by the Cosmos transaction.
+type CosmosPseudoTx struct {
+	Hash         common.Hash
+	CosmosTxHash common.Hash
+	From         common.Address
+	To           common.Address
+	Value        *big.Int
+}
+
+// Sources of a Transfer
+const (
+	// TransferSourceCall is the value transferred by an EVM transaction
+	TransferSourceCall = "call"
+	// TransferSourceInternal is a value transfer of an internal call,
+	// contract creation or self destruct of an EVM transaction
+	TransferSourceInternal = "internal"
+	// TransferSourceBank is a bank transfer, made by a Cosmos message or by a
+	// precompile called by an EVM transaction
+	TransferSourceBank = "bank"
+)
+
+// Transfer represents a transfer of native coins in a block, as notified by
+// the transfers subscription. The value of the EVM transfers is in the
+// extended denom of the EVM coin, the bank transfers in their own denom.
+type Transfer struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *hexutil.Big   `json:"value"`
+	Denom       string         `json:"denom"`
+	TxHash      common.Hash    `json:"txHash"`
+	Source      string         `json:"source"`
+}
+
+// TraceEntry represents a call frame of a transaction, as returned by
+// ots_traceTransaction
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// TransactionsWithReceipts represents a page of transactions of an address
+// with their receipts, as returned by ots_searchTransactionsBefore and
+// ots_searchTransactionsAfter
+type TransactionsWithReceipts struct {
+	Txs       []*RPCTransaction        `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
 // Embedded TraceConfig type to store raw JSON data of config in custom field
 type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// TraceCallConfig is the trace config of debug_traceCallMany, extended with
+// the state overrides applied before tracing.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride `json:"stateOverrides"`
+}
+
+// MaxTraceCallManyBundles and MaxTraceCallManyCalls are the maximum numbers of
+// bundles and of calls across all the bundles of a debug_traceCallMany
+// request.
+const (
+	MaxTraceCallManyBundles = 100
+	MaxTraceCallManyCalls   = 1000
+)
+
+// Bundle is a list of calls traced sequentially by debug_traceCallMany. The
+// block overrides are decoded to reject them, since the calls are traced in
+// the context of the requested block.
+type Bundle struct {
+	Transactions  []evmtypes.TransactionArgs `json:"transactions"`
+	BlockOverride *BlockOverrides            `json:"blockOverride"`
+}
+
+// StateContext is the state on top of which debug_traceCallMany traces the
+// bundles. Only the state at the end of the block, with a nil or -1
+// transaction index, is supported.
+type StateContext struct {
+	BlockNumber      BlockNumberOrHash `json:"blockNumber"`
+	TransactionIndex *int              `json:"transactionIndex"`
+}
+
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the trie.
+}
+
+// StorageMap is a set of storage slots, indexed by the hash of their key.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage slot and its value.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// MaxDumpBlockEntries is the maximum number of accounts and storage slots
+// returned by debug_dumpBlock, the larger states being paged through with
+// debug_accountRange instead.
+const MaxDumpBlockEntries = 100_000
+
+// AccountRangeResult is the result of the debug_accountRange and
+// debug_dumpBlock API calls.
+type AccountRangeResult struct {
+	Accounts map[common.Address]DumpAccount `json:"accounts"`
+	Next     hexutil.Bytes                  `json:"next,omitempty"` // nil if no more accounts
+}
+
+// DumpAccount is the state of an account returned by debug_accountRange.
+type DumpAccount struct {
+	Balance  string                      `json:"balance"`
+	Nonce    uint64                      `json:"nonce"`
+	CodeHash hexutil.Bytes               `json:"codeHash"`
+	Code     hexutil.Bytes               `json:"code,omitempty"`
+	Storage  map[common.Hash]common.Hash `json:"storage,omitempty"`
+	Address  *common.Address             `json:"address,omitempty"`
+}
+
+// CosmosTxResult is a Cosmos transaction with its execution result, as
+// returned by cosmos_getCosmosTx.
+type CosmosTxResult struct {
+	Hash             string          `json:"hash"`
+	BlockHash        common.Hash     `json:"blockHash"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64  `json:"transactionIndex"`
+	Tx               json.RawMessage `json:"tx"`
+	Code             uint32          `json:"code"`
+	Codespace        string          `json:"codespace,omitempty"`
+	Log              string          `json:"log,omitempty"`
+	GasWanted        hexutil.Uint64  `json:"gasWanted"`
+	GasUsed          hexutil.Uint64  `json:"gasUsed"`
+	Events           []abci.Event    `json:"events"`
+}
+
+// CosmosBalance is the bank balance of a denom, with the address of the ERC20
+// token it is mapped to if any, as returned by cosmos_getBalances.
+type CosmosBalance struct {
+	Denom        string          `json:"denom"`
+	Amount       *hexutil.Big    `json:"amount"`
+	ERC20Address *common.Address `json:"erc20Address,omitempty"`
+}
+
+// CosmosDelegation is a staking delegation, as returned by
+// cosmos_getDelegations.
+type CosmosDelegation struct {
+	Validator string       `json:"validator"`
+	Shares    string       `json:"shares"`
+	Denom     string       `json:"denom"`
+	Amount    *hexutil.Big `json:"amount"`
+}
+
+// PrivateTxArgs are the arguments of eth_sendPrivateTransaction.
+type PrivateTxArgs struct {
+	Tx             hexutil.Bytes   `json:"tx"`
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber"`
+}
+
+// SendBundleArgs are the arguments of eth_sendBundle. The timestamps are in
+// seconds.
+type SendBundleArgs struct {
+	Txs          []hexutil.Bytes `json:"txs"`
+	BlockNumber  hexutil.Uint64  `json:"blockNumber"`
+	MinTimestamp *uint64         `json:"minTimestamp"`
+	MaxTimestamp *uint64         `json:"maxTimestamp"`
+}
+
+// SendBundleResult is the result of eth_sendBundle.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// CallBundleArgs are the arguments of eth_callBundle. The bundle is simulated
+// in the block with the given number and timestamp, on top of the state of
+// the given block.
+type CallBundleArgs struct {
+	Txs              []hexutil.Bytes `json:"txs"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	StateBlockNumber BlockNumber     `json:"stateBlockNumber"`
+	Timestamp        *uint64         `json:"timestamp"`
+}
+
+// CallBundleResult is the result of eth_callBundle.
+type CallBundleResult struct {
+	BundleHash       common.Hash          `json:"bundleHash"`
+	Results          []CallBundleTxResult `json:"results"`
+	TotalGasUsed     hexutil.Uint64       `json:"totalGasUsed"`
+	StateBlockNumber hexutil.Uint64       `json:"stateBlockNumber"`
+}
+
+// CallBundleTxResult is the simulation result of a transaction of a bundle.
+type CallBundleTxResult struct {
+	TxHash      common.Hash     `json:"txHash"`
+	FromAddress common.Address  `json:"fromAddress"`
+	ToAddress   *common.Address `json:"toAddress"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Value       hexutil.Bytes   `json:"value,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	Revert      string          `json:"revert,omitempty"`
+}
@@ -528,6 +528,53 @@ func RPCMarshalReceipt(receipt *ethtypes.Receipt, tx *ethtypes.Transaction, from
 	return fields, nil
 }
 
+// NewRPCCosmosPseudoTx returns the transaction of a Cosmos pseudo transaction
+// at the given index of a block.
+func NewRPCCosmosPseudoTx(tx *CosmosPseudoTx, blockHash common.Hash, blockNumber, index uint64, chainID *big.Int) *RPCTransaction {
+	to := tx.To
+	return &RPCTransaction{
+		BlockHash:        &blockHash,
+		BlockNumber:      (*hexutil.Big)(new(big.Int).SetUint64(blockNumber)),
+		From:             tx.From,
+		Gas:              0,
+		GasPrice:         (*hexutil.Big)(new(big.Int)),
+		Hash:             tx.Hash,
+		Input:            hexutil.Bytes{},
+		To:               &to,
+		TransactionIndex: (*hexutil.Uint64)(&index),
+		Value:            (*hexutil.Big)(tx.Value),
+		Type:             hexutil.Uint64(CosmosTxType),
+		ChainID:          (*hexutil.Big)(chainID),
+		V:                (*hexutil.Big)(new(big.Int)),
+		R:                (*hexutil.Big)(new(big.Int)),
+		S:                (*hexutil.Big)(new(big.Int)),
+		CosmosTxHash:     &tx.CosmosTxHash,
+	}
+}
+
+// RPCMarshalCosmosPseudoReceipt marshals the receipt of a Cosmos pseudo
+// transaction at the given index of a block, which always succeeds without
+// using gas nor emitting logs.
+func RPCMarshalCosmosPseudoReceipt(tx *CosmosPseudoTx, blockHash common.Hash, blockNumber, index, cumulativeGasUsed uint64) map[string]interface{} {
+	return map[string]interface{}{
+		"blockHash":         blockHash,
+		"blockNumber":       hexutil.Uint64(blockNumber),
+		"transactionHash":   tx.Hash,
+		"transactionIndex":  hexutil.Uint64(index),
+		"from":              tx.From,
+		"to":                tx.To,
+		"gasUsed":           hexutil.Uint64(0),
+		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"contractAddress":   nil,
+		"logs":              []*ethtypes.Log{},
+		"logsBloom":         ethtypes.Bloom{},
+		"type":              hexutil.Uint(CosmosTxType),
+		"effectiveGasPrice": (*hexutil.Big)(new(big.Int)),
+		"status":            hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+		"cosmosTxHash":      tx.CosmosTxHash,
+	}
+}
+
 // EffectiveGasPrice computes the transaction gas fee, based on the given basefee value.
 //
 // price = min(gasTipCap + baseFee, gasFeeCap)
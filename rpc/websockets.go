@@ -11,11 +11,14 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -23,9 +26,16 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/monitor"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
 
@@ -34,6 +44,10 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// syncingPollInterval is the interval at which the syncing subscription
+	// polls the sync status of the node
+	syncingPollInterval = time.Second
 )
 
 type WebsocketsServer interface {
@@ -76,18 +90,38 @@ type websocketsServer struct {
 	allowedOrigins []string // allowed origins for WebSocket connections
 	api            *pubSubAPI
 	logger         log.Logger
+	auth           *auth.Authenticator
+	limiter        *ratelimit.Limiter
+	monitor        *monitor.Monitor
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	authenticator *auth.Authenticator,
+	limiter *ratelimit.Limiter,
+	rpcMonitor *monitor.Monitor,
+	transfers transfersBackend,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
+	// the transfers are derived from the call traces, which are only served
+	// with the debug namespace
+	if !slices.Contains(cfg.JSONRPC.API, DebugNamespace) {
+		transfers = nil
+	}
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
+		api:            newPubSubAPI(clientCtx, logger, stream, transfers),
 		logger:         logger,
+		auth:           authenticator,
+		limiter:        limiter,
+		monitor:        rpcMonitor,
 	}
 }
 
@@ -181,6 +215,14 @@ func (s *websocketsServer) checkOrigin(r *http.Request) bool {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	permissions, err := s.auth.Authenticate(r)
+	if err != nil {
+		s.logger.Debug("websocket connection rejected", "error", err.Error())
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.monitor.Reject(nil, 0, time.Now())
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: s.checkOrigin,
 	}
@@ -194,8 +236,10 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:         new(sync.Mutex),
+		conn:        conn,
+		permissions: permissions,
+		clientID:    s.limiter.ClientID(permissions, r),
 	}
 
 	s.readLoop(ws)
@@ -217,6 +261,11 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// permissions restricts the methods the connection can call, nil if
+	// authentication is disabled
+	permissions *auth.Permissions
+	// clientID identifies the connection client for rate limiting
+	clientID string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -259,6 +308,24 @@ readLoop:
 			return
 		}
 
+		// the messages that cannot be parsed are rejected, as their methods
+		// cannot be checked
+		reqs, err := auth.ParseRequests(mb)
+		if err == nil {
+			err = auth.CheckMethods(wsConn.permissions, reqs)
+		}
+		if err != nil {
+			s.monitor.Reject(reqs, len(mb), time.Now())
+			s.sendErrResponse(wsConn, err.Error())
+			continue
+		}
+
+		if !s.limiter.Allow(wsConn.clientID, reqs) {
+			s.monitor.Reject(reqs, len(mb), time.Now())
+			_ = wsConn.WriteJSON(ratelimit.LimitExceededResponse(mb, reqs)) // #nosec G703
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -300,15 +367,18 @@ readLoop:
 			continue
 		}
 
+		start := time.Now()
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				s.monitor.Record(method, len(mb), start, true)
 				continue
 			}
 
 			subID := rpc.NewID()
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			s.monitor.Record(method, len(mb), start, err != nil)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -328,11 +398,13 @@ readLoop:
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				s.monitor.Record(method, len(mb), start, true)
 				continue
 			}
 
 			id, ok := params[0].(string)
 			if !ok {
+				s.monitor.Record(method, len(mb), start, true)
 				s.sendErrResponse(wsConn, "invalid parameters")
 				continue
 			}
@@ -343,6 +415,7 @@ readLoop:
 				delete(subscriptions, subID)
 				unsubFn()
 			}
+			s.monitor.Record(method, len(mb), start, false)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -388,6 +461,16 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+
+	// the request is already authorized, forward it with full access
+	token, err := s.auth.InternalToken()
+	if err != nil {
+		return errors.Wrap(err, "could not create internal token")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -410,21 +493,39 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	return wsConn.WriteJSON(wsSend)
 }
 
+// transfersBackend derives the transfers of the blocks notified by the
+// transfers subscription.
+type transfersBackend interface {
+	GetBlockTransfers(height int64) ([]*rpctypes.Transfer, error)
+}
+
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *stream.RPCStream
 	logger    log.Logger
 	clientCtx client.Context
+	// transfers is shared by the transfers subscriptions, nil without a
+	// backend
+	transfers *transfersFeed
+	// syncing is shared by the syncing subscriptions, nil without a node client
+	syncing *syncingPoller
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, transfers transfersBackend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
-	return &pubSubAPI{
+	api := &pubSubAPI{
 		events:    stream,
 		logger:    logger,
 		clientCtx: clientCtx,
 	}
+	if transfers != nil {
+		api.transfers = newTransfersFeed(stream, transfers, logger)
+	}
+	if clientCtx.Client != nil {
+		api.syncing = newSyncingPoller(clientCtx.Client, logger)
+	}
+	return api
 }
 
 func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (context.CancelFunc, error) {
@@ -443,9 +544,20 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		var fullTx bool
+		if len(params) > 1 {
+			if fullTx, ok = params[1].(bool); !ok {
+				return nil, errors.New("invalid fullTx parameter; must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
+	case "transfers":
+		if len(params) > 1 {
+			return api.subscribeTransfers(wsConn, subID, params[1])
+		}
+		return api.subscribeTransfers(wsConn, subID, nil)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
@@ -512,21 +624,11 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 
 		if params["address"] != nil {
-			switch address := params["address"].(type) {
-			case string:
-				crit.Addresses = []common.Address{common.HexToAddress(address)}
-			case []any:
-				for _, addr := range address {
-					address, ok := addr.(string)
-					if !ok {
-						return nil, errors.New("invalid address")
-					}
-
-					crit.Addresses = append(crit.Addresses, common.HexToAddress(address))
-				}
-			default:
-				return nil, errors.New("invalid addresses; must be address or array of addresses")
+			addresses, err := parseAddresses(params["address"])
+			if err != nil {
+				return nil, err
 			}
+			crit.Addresses = addresses
 		}
 
 		if params["topics"] != nil {
@@ -626,18 +728,25 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+// subscribePendingTransactions streams the hashes of the transactions entering
+// the mempool, or the full transactions if fullTx is set.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (context.CancelFunc, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
-	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
-		for _, hash := range items {
+	go api.events.PendingTxStream().Subscribe(ctx, func(items []*ethtypes.Transaction, _ int) error {
+		for _, tx := range items {
+			var result interface{} = tx.Hash()
+			if fullTx {
+				result = rpctypes.NewRPCPendingTransaction(tx, nil, evmtypes.GetEthChainConfig())
+			}
+
 			// write to ws conn
 			res := &SubscriptionNotification{
 				Jsonrpc: "2.0",
 				Method:  "eth_subscription",
 				Params: &SubscriptionResult{
 					Subscription: subID,
-					Result:       hash,
+					Result:       result,
 				},
 			}
 
@@ -659,8 +768,440 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// SyncingResult is the notification of the syncing subscription.
+type SyncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  SyncingStatus `json:"status"`
+}
+
+// SyncingStatus is the progress of the node catching up with the network.
+type SyncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// subscribeSyncing notifies the sync status of the node on subscription and
+// every time the node starts or stops catching up with the network: a
+// SyncingResult while catching up and false once caught up, like geth.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	if api.syncing == nil {
+		return nil, errors.New("syncing subscription requires a node client")
+	}
+
+	statuses, unsubscribe := api.syncing.subscribe()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case status := <-statuses:
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       status,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+			}
+		}
+	}()
+
+	return cancel, nil
+}
+
+// consensusStateDumper is implemented by the node clients exposing the
+// consensus state of the peers, such as the local and HTTP CometBFT clients.
+type consensusStateDumper interface {
+	DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpConsensusState, error)
+}
+
+// syncingPoller polls the sync status of the node once for all the syncing
+// subscriptions, while there is at least one of them.
+type syncingPoller struct {
+	client client.CometRPC
+	logger log.Logger
+
+	mu     sync.Mutex
+	subs   map[chan interface{}]struct{}
+	cancel context.CancelFunc
+	// last is the latest status, notified to the new subscribers
+	last interface{}
+}
+
+func newSyncingPoller(client client.CometRPC, logger log.Logger) *syncingPoller {
+	return &syncingPoller{
+		client: client,
+		logger: logger,
+		subs:   make(map[chan interface{}]struct{}),
+	}
+}
+
+// subscribe returns a channel receiving the sync status changes, starting
+// with the current status, and the function removing the subscription.
+func (p *syncingPoller) subscribe() (<-chan interface{}, func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch := make(chan interface{}, 1)
+	p.subs[ch] = struct{}{}
+	if p.last != nil {
+		ch <- p.last
+	}
+	if p.cancel == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.cancel = cancel
+		go p.poll(ctx)
+	}
+
+	return ch, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		delete(p.subs, ch)
+		if len(p.subs) == 0 && p.cancel != nil {
+			p.cancel()
+			p.cancel = nil
+			p.last = nil
+		}
+	}
+}
+
+func (p *syncingPoller) poll(ctx context.Context) {
+	ticker := time.NewTicker(syncingPollInterval)
+	defer ticker.Stop()
+
+	var last *bool
+	for {
+		status, err := p.client.Status(ctx)
+		switch {
+		case err != nil:
+			p.logger.Debug("failed to query node status", "error", err.Error())
+		case last == nil || *last != status.SyncInfo.CatchingUp:
+			catchingUp := status.SyncInfo.CatchingUp
+			last = &catchingUp
+
+			var result interface{} = false
+			if catchingUp {
+				highest := p.highestBlock(ctx, status.SyncInfo.LatestBlockHeight)
+				result = &SyncingResult{
+					Syncing: true,
+					Status: SyncingStatus{
+						StartingBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
+						CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
+						HighestBlock:  hexutil.Uint64(highest),                             //nolint:gosec // G115 // won't exceed uint64
+					},
+				}
+			}
+			p.publish(ctx, result)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish notifies a status to the subscribers, replacing the status they
+// have not received yet.
+func (p *syncingPoller) publish(ctx context.Context, status interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// the poller was stopped, and possibly replaced by a new one
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.last = status
+	for ch := range p.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- status
+	}
+}
+
+// highestBlock returns the highest block committed by the peers of the node,
+// derived from the heights of their consensus round states, or the latest
+// block of the node if the peer states are unknown.
+func (p *syncingPoller) highestBlock(ctx context.Context, latest int64) int64 {
+	dumper, ok := p.client.(consensusStateDumper)
+	if !ok {
+		return latest
+	}
+
+	res, err := dumper.DumpConsensusState(ctx)
+	if err != nil {
+		p.logger.Debug("failed to query the peer states", "error", err.Error())
+		return latest
+	}
+
+	highest := latest
+	for _, peer := range res.Peers {
+		var state struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			continue
+		}
+		// the peers are at the round of the block after their latest one
+		if state.RoundState.Height-1 > highest {
+			highest = state.RoundState.Height - 1
+		}
+	}
+	return highest
+}
+
+// TransfersError is notified to the transfers subscriptions when some of
+// the transfers of a block can't be derived, such as the call transfers of a
+// block which can't be traced, the other transfers being notified anyway.
+type TransfersError struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	Error       string         `json:"error"`
+}
+
+// blockTransfers are the transfers of a new block, err reporting the ones
+// which can't be derived.
+type blockTransfers struct {
+	height    int64
+	transfers []*rpctypes.Transfer
+	err       error
+}
+
+// transfersQueueSize is the number of blocks whose transfers are queued for a
+// transfers subscription, which is dropped when falling further behind.
+const transfersQueueSize = 64
+
+// transfersFeed derives the transfers of the new blocks once for all the
+// transfers subscriptions, while there is at least one of them.
+type transfersFeed struct {
+	events  *stream.RPCStream
+	backend transfersBackend
+	logger  log.Logger
+
+	mu     sync.Mutex
+	subs   map[chan *blockTransfers]struct{}
+	cancel context.CancelFunc
+}
+
+func newTransfersFeed(events *stream.RPCStream, backend transfersBackend, logger log.Logger) *transfersFeed {
+	return &transfersFeed{
+		events:  events,
+		backend: backend,
+		logger:  logger,
+		subs:    make(map[chan *blockTransfers]struct{}),
+	}
+}
+
+// subscribe returns a channel receiving the transfers of the new blocks,
+// closed if the subscription falls behind, and the function removing the
+// subscription.
+func (f *transfersFeed) subscribe() (<-chan *blockTransfers, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan *blockTransfers, transfersQueueSize)
+	f.subs[ch] = struct{}{}
+	if f.cancel == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		f.cancel = cancel
+		//nolint: errcheck
+		go f.events.HeaderStream().Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
+			for _, header := range headers {
+				f.publish(ctx, header.EthHeader.Number.Int64())
+			}
+			return nil
+		})
+	}
+
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, ok := f.subs[ch]; ok {
+			f.remove(ch)
+		}
+	}
+}
+
+// publish derives the transfers of a new block and notifies them to the
+// subscribers, dropping the ones whose queue is full.
+func (f *transfersFeed) publish(ctx context.Context, height int64) {
+	transfers, err := f.backend.GetBlockTransfers(height)
+	if err != nil {
+		f.logger.Error("failed to get block transfers", "height", height, "error", err.Error())
+	}
+	block := &blockTransfers{height: height, transfers: transfers, err: err}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// the feed was stopped, and possibly restarted
+	if ctx.Err() != nil {
+		return
+	}
+
+	for ch := range f.subs {
+		select {
+		case ch <- block:
+		default:
+			f.remove(ch)
+			close(ch)
+		}
+	}
+}
+
+// remove removes a subscription, stopping the feed after the last one. The
+// caller must hold the lock.
+func (f *transfersFeed) remove(ch chan *blockTransfers) {
+	delete(f.subs, ch)
+	if len(f.subs) == 0 && f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+	}
+}
+
+// subscribeTransfers notifies the transfers of native coins of the new blocks,
+// optionally only the ones from or to the given addresses. The transfers which
+// can't be derived for a block are reported by a TransfersError notification.
+func (api *pubSubAPI) subscribeTransfers(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, error) {
+	if api.transfers == nil {
+		return nil, errors.New("transfers subscription requires tracing, enable the debug namespace")
+	}
+
+	var addresses []common.Address
+	if extra != nil {
+		params, ok := extra.(map[string]interface{})
+		if !ok {
+			api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
+			return nil, errors.New("invalid criteria")
+		}
+
+		if params["address"] != nil {
+			var err error
+			if addresses, err = parseAddresses(params["address"]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	blocks, unsubscribe := api.transfers.subscribe()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer unsubscribe()
+
+		dropPeer := func() {
+			try(func() {
+				_ = wsConn.Close()
+			}, api.logger, "closing websocket peer sub")
+		}
+
+		for {
+			var block *blockTransfers
+			select {
+			case <-ctx.Done():
+				return
+			case b, ok := <-blocks:
+				if !ok {
+					api.logger.Debug("transfers subscription fell behind, will drop peer")
+					dropPeer()
+					return
+				}
+				block = b
+			}
+
+			var results []interface{}
+			for _, transfer := range filterTransfers(block.transfers, addresses) {
+				results = append(results, transfer)
+			}
+			if block.err != nil {
+				results = append(results, &TransfersError{
+					BlockNumber: hexutil.Uint64(block.height), //nolint:gosec // G115 // heights are positive
+					Error:       block.err.Error(),
+				})
+			}
+
+			for _, result := range results {
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing transfer, will drop peer", "error", err.Error())
+					if err != websocket.ErrCloseSent {
+						dropPeer()
+					}
+					return
+				}
+			}
+		}
+	}()
+
+	return cancel, nil
+}
+
+// filterTransfers returns the transfers from or to one of the addresses, all
+// of them if no address is given.
+func filterTransfers(transfers []*rpctypes.Transfer, addresses []common.Address) []*rpctypes.Transfer {
+	if len(addresses) == 0 {
+		return transfers
+	}
+
+	var filtered []*rpctypes.Transfer
+	for _, transfer := range transfers {
+		if slices.Contains(addresses, transfer.From) || slices.Contains(addresses, transfer.To) {
+			filtered = append(filtered, transfer)
+		}
+	}
+	return filtered
+}
+
+// parseAddresses parses the address criteria of a subscription, an address
+// or an array of addresses.
+func parseAddresses(param interface{}) ([]common.Address, error) {
+	switch address := param.(type) {
+	case string:
+		return []common.Address{common.HexToAddress(address)}, nil
+	case []any:
+		addresses := make([]common.Address, 0, len(address))
+		for _, addr := range address {
+			address, ok := addr.(string)
+			if !ok {
+				return nil, errors.New("invalid address")
+			}
+
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+		return addresses, nil
+	default:
+		return nil, errors.New("invalid addresses; must be address or array of addresses")
+	}
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
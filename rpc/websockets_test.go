@@ -2,18 +2,34 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
 
@@ -33,7 +49,7 @@ func newTestWebsocketServer() *websocketsServer {
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
@@ -261,3 +277,464 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestSubscribePendingTransactions(t *testing.T) {
+	chainConfig := evmtypes.DefaultChainConfig(evmtypes.DefaultEVMChainID)
+	require.NoError(t, evmtypes.SetChainConfig(chainConfig))
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(new(big.Int).SetUint64(chainConfig.ChainId))
+	tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21000,
+		To:        &common.Address{},
+		Value:     big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		params []interface{}
+		expErr bool
+		verify func(t *testing.T, result json.RawMessage)
+	}{
+		{
+			"hashes only",
+			[]interface{}{"newPendingTransactions"},
+			false,
+			func(t *testing.T, result json.RawMessage) {
+				t.Helper()
+				var hash common.Hash
+				require.NoError(t, json.Unmarshal(result, &hash))
+				require.Equal(t, tx.Hash(), hash)
+			},
+		},
+		{
+			"full transactions",
+			[]interface{}{"newPendingTransactions", true},
+			false,
+			func(t *testing.T, result json.RawMessage) {
+				t.Helper()
+				var rpcTx map[string]interface{}
+				require.NoError(t, json.Unmarshal(result, &rpcTx))
+				require.Equal(t, tx.Hash().Hex(), rpcTx["hash"])
+				require.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Hex(), common.HexToAddress(rpcTx["from"].(string)).Hex())
+				require.Nil(t, rpcTx["blockHash"])
+			},
+		},
+		{
+			"invalid fullTx parameter",
+			[]interface{}{"newPendingTransactions", "yes"},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newTestWebsocketServer()
+			srv.api = newPubSubAPI(client.Context{}, log.NewNopLogger(), stream.NewRPCStreams(nil, log.NewNopLogger(), nil), nil)
+
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			u, _ := url.Parse(ts.URL)
+			u.Scheme = "ws"
+			conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			require.NoError(t, conn.WriteJSON(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"method":  "eth_subscribe",
+				"params":  tc.params,
+			}))
+
+			var res map[string]json.RawMessage
+			require.NoError(t, conn.ReadJSON(&res))
+			if tc.expErr {
+				require.Contains(t, res, "error")
+				return
+			}
+			require.Contains(t, res, "result")
+
+			// the subscription may start reading the stream after the first
+			// transactions are added, keep feeding it until notified
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				ticker := time.NewTicker(10 * time.Millisecond)
+				defer ticker.Stop()
+				for {
+					srv.api.events.ListenPendingTx(tx)
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+
+			var notification struct {
+				Method string `json:"method"`
+				Params struct {
+					Result json.RawMessage `json:"result"`
+				} `json:"params"`
+			}
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+			require.NoError(t, conn.ReadJSON(&notification))
+			require.Equal(t, "eth_subscription", notification.Method)
+			tc.verify(t, notification.Params.Result)
+		})
+	}
+}
+
+func TestSubscribeSyncingWithoutClient(t *testing.T) {
+	api := newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil)
+	_, err := api.subscribe(nil, "0x1", []interface{}{"syncing"})
+	require.ErrorContains(t, err, "requires a node client")
+}
+
+// syncingClient is a node client reporting a settable sync status, and the
+// consensus state of a peer at a given height.
+type syncingClient struct {
+	client.CometRPC
+	mu         sync.Mutex
+	catchingUp bool
+	polls      int
+}
+
+func (c *syncingClient) setCatchingUp(catchingUp bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.catchingUp = catchingUp
+}
+
+func (c *syncingClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.polls++
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		CatchingUp:          c.catchingUp,
+		EarliestBlockHeight: 1,
+		LatestBlockHeight:   50,
+	}}, nil
+}
+
+func (c *syncingClient) DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error) {
+	return &coretypes.ResultDumpConsensusState{Peers: []coretypes.PeerStateInfo{
+		{PeerState: json.RawMessage(`{"round_state":{"height":"101"}}`)},
+		{PeerState: json.RawMessage(`{"round_state":{"height":"20"}}`)},
+	}}, nil
+}
+
+func TestSyncingPoller(t *testing.T) {
+	c := &syncingClient{catchingUp: true}
+	poller := newSyncingPoller(c, log.NewNopLogger())
+
+	receive := func(ch <-chan interface{}) interface{} {
+		select {
+		case status := <-ch:
+			return status
+		case <-time.After(5 * time.Second):
+			t.Fatal("no sync status notified")
+			return nil
+		}
+	}
+
+	// the highest block is the latest block of the peers
+	first, unsubscribeFirst := poller.subscribe()
+	require.Equal(t, &SyncingResult{Syncing: true, Status: SyncingStatus{StartingBlock: 1, CurrentBlock: 50, HighestBlock: 100}}, receive(first))
+
+	// a new subscriber receives the current status from the same poller
+	second, unsubscribeSecond := poller.subscribe()
+	require.Equal(t, &SyncingResult{Syncing: true, Status: SyncingStatus{StartingBlock: 1, CurrentBlock: 50, HighestBlock: 100}}, receive(second))
+
+	// both subscribers are notified false once caught up
+	c.setCatchingUp(false)
+	require.Equal(t, false, receive(first))
+	require.Equal(t, false, receive(second))
+
+	// the poller stops with the last subscription
+	unsubscribeFirst()
+	unsubscribeSecond()
+	poller.mu.Lock()
+	require.Nil(t, poller.cancel)
+	poller.mu.Unlock()
+
+	c.mu.Lock()
+	polls := c.polls
+	c.mu.Unlock()
+	time.Sleep(2 * syncingPollInterval)
+	c.mu.Lock()
+	require.LessOrEqual(t, c.polls, polls+1)
+	c.mu.Unlock()
+}
+
+func TestWebsocketAuth(t *testing.T) {
+	secret := common.Hash{1}.Bytes()
+	authenticator, err := auth.NewAuthenticator(config.JSONRPCConfig{
+		EnableAuth: true,
+		PublicAPI:  []string{"eth"},
+		AuthKeys: []config.AuthKeyConfig{{
+			ID:         "debugger",
+			Secret:     "0x" + common.Bytes2Hex(secret),
+			Namespaces: []string{"debug"},
+		}},
+	})
+	require.NoError(t, err)
+
+	srv := newTestWebsocketServer()
+	srv.auth = authenticator
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	// invalid token
+	_, httpResp, err := websocket.DefaultDialer.Dial(u.String(), http.Header{"Authorization": []string{"Bearer invalid"}})
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+	httpResp.Body.Close()
+
+	// public connection cannot call the debug namespace
+	conn, httpResp, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "debug_traceTransaction", "params": []interface{}{}}))
+	var res ErrorResponseJSON
+	require.NoError(t, conn.ReadJSON(&res))
+	require.NotNil(t, res.Error)
+	require.Contains(t, res.Error.Message, "debug_traceTransaction is not allowed")
+
+	// nor with a batch followed by other data
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`[{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}]x`)))
+	res = ErrorResponseJSON{}
+	require.NoError(t, conn.ReadJSON(&res))
+	require.NotNil(t, res.Error)
+	require.Contains(t, res.Error.Message, auth.ErrInvalidRequest.Error())
+
+	// authenticated connection
+	token, err := auth.NewToken(secret, time.Now())
+	require.NoError(t, err)
+	authConn, httpResp, err := websocket.DefaultDialer.Dial(u.String(), http.Header{"Authorization": []string{"Bearer " + token}})
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	authConn.Close()
+}
+
+func TestWebsocketRateLimit(t *testing.T) {
+	srv := newTestWebsocketServer()
+	srv.limiter = ratelimit.NewLimiter(config.JSONRPCConfig{
+		RateLimit:      0.001,
+		RateLimitBurst: 1,
+	})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, httpResp, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	defer conn.Close()
+
+	// the first request spends the whole burst
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "eth_unsubscribe", "params": []interface{}{"0x1"}}))
+	var res map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&res))
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 2, "method": "eth_unsubscribe", "params": []interface{}{"0x1"}}))
+	var limited ErrorResponseJSON
+	require.NoError(t, conn.ReadJSON(&limited))
+	require.NotNil(t, limited.Error)
+	require.Equal(t, int64(ratelimit.ErrCodeLimitExceeded), limited.Error.Code.Int64())
+	require.Equal(t, ratelimit.ErrMsgLimitExceeded, limited.Error.Message)
+}
+
+// blockEventsClient streams the new block events sent to blocks.
+type blockEventsClient struct {
+	blocks chan coretypes.ResultEvent
+}
+
+func (c *blockEventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	if query == cmttypes.QueryForEvent(cmttypes.EventNewBlock).String() {
+		return c.blocks, nil
+	}
+	return make(chan coretypes.ResultEvent), nil
+}
+
+func (c *blockEventsClient) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (c *blockEventsClient) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+// fakeTransfersBackend returns the same transfers for every block, along
+// with err, counting the calls per block.
+type fakeTransfersBackend struct {
+	transfers []*rpctypes.Transfer
+	err       error
+
+	mu    sync.Mutex
+	calls map[int64]int
+}
+
+func (b *fakeTransfersBackend) GetBlockTransfers(height int64) ([]*rpctypes.Transfer, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.calls == nil {
+		b.calls = make(map[int64]int)
+	}
+	b.calls[height]++
+
+	transfers := make([]*rpctypes.Transfer, len(b.transfers))
+	for i, transfer := range b.transfers {
+		copied := *transfer
+		copied.BlockNumber = hexutil.Uint64(height) //nolint:gosec // G115 // test heights are positive
+		transfers[i] = &copied
+	}
+	return transfers, b.err
+}
+
+func TestSubscribeTransfers(t *testing.T) {
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	carol := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	backend := &fakeTransfersBackend{
+		transfers: []*rpctypes.Transfer{
+			{From: alice, To: carol, Value: (*hexutil.Big)(big.NewInt(1)), Denom: "aatom", Source: rpctypes.TransferSourceCall},
+			{From: alice, To: bob, Value: (*hexutil.Big)(big.NewInt(2)), Denom: "uosmo", Source: rpctypes.TransferSourceBank},
+		},
+		err: errors.New("failed to trace block"),
+	}
+
+	evtClient := &blockEventsClient{blocks: make(chan coretypes.ResultEvent, 1)}
+	srv := newTestWebsocketServer()
+	srv.api = newPubSubAPI(client.Context{}, log.NewNopLogger(), stream.NewRPCStreams(evtClient, log.NewNopLogger(), nil), backend)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// one subscription to the transfers of bob and one to the ones of carol
+	subs := map[string]common.Address{}
+	for i, addr := range []common.Address{bob, carol} {
+		require.NoError(t, conn.WriteJSON(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      i,
+			"method":  "eth_subscribe",
+			"params":  []interface{}{"transfers", map[string]interface{}{"address": []interface{}{addr.Hex()}}},
+		}))
+		var res struct {
+			Result string `json:"result"`
+		}
+		require.NoError(t, conn.ReadJSON(&res))
+		require.NotEmpty(t, res.Result)
+		subs[res.Result] = addr
+	}
+
+	// the subscriptions may start reading the stream after the first blocks
+	// are added, keep feeding it until notified
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for height := int64(7); ; height++ {
+			select {
+			case evtClient.blocks <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlock{
+				Block: &cmttypes.Block{Header: cmttypes.Header{Height: height}},
+			}}:
+			case <-done:
+				return
+			}
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	// each subscription is notified of its transfers, then of the failure to
+	// derive the others
+	type notification struct {
+		Method string `json:"method"`
+		Params struct {
+			Subscription string          `json:"subscription"`
+			Result       json.RawMessage `json:"result"`
+		} `json:"params"`
+	}
+	received := map[string][]json.RawMessage{}
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	for len(received[subscriptionOf(subs, bob)]) < 2 || len(received[subscriptionOf(subs, carol)]) < 2 {
+		var n notification
+		require.NoError(t, conn.ReadJSON(&n))
+		require.Equal(t, "eth_subscription", n.Method)
+		received[n.Params.Subscription] = append(received[n.Params.Subscription], n.Params.Result)
+	}
+	for subID, addr := range subs {
+		var transfer rpctypes.Transfer
+		require.NoError(t, json.Unmarshal(received[subID][0], &transfer))
+		require.Equal(t, addr, transfer.To)
+
+		var transfersErr TransfersError
+		require.NoError(t, json.Unmarshal(received[subID][1], &transfersErr))
+		require.Equal(t, transfer.BlockNumber, transfersErr.BlockNumber)
+		require.Equal(t, "failed to trace block", transfersErr.Error)
+	}
+
+	// the transfers of a block are derived once for all the subscriptions
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+	require.NotEmpty(t, backend.calls)
+	for height, calls := range backend.calls {
+		require.Equal(t, 1, calls, "height %d", height)
+	}
+}
+
+func subscriptionOf(subs map[string]common.Address, addr common.Address) string {
+	for subID, subAddr := range subs {
+		if subAddr == addr {
+			return subID
+		}
+	}
+	return ""
+}
+
+func TestTransfersRequireTracing(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.API = []string{EthNamespace}
+	srv := NewWebsocketsServer(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, cfg, nil, nil, nil, &fakeTransfersBackend{})
+	require.Nil(t, srv.(*websocketsServer).api.transfers)
+
+	cfg.JSONRPC.API = append(cfg.JSONRPC.API, DebugNamespace)
+	srv = NewWebsocketsServer(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, cfg, nil, nil, nil, &fakeTransfersBackend{})
+	require.NotNil(t, srv.(*websocketsServer).api.transfers)
+}
+
+func TestSubscribeTransfersInvalidParams(t *testing.T) {
+	api := newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil)
+	_, err := api.subscribe(nil, "0x1", []interface{}{"transfers"})
+	require.ErrorContains(t, err, "requires tracing")
+
+	api = newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, &fakeTransfersBackend{})
+	_, err = api.subscribe(nil, "0x1", []interface{}{"transfers", map[string]interface{}{"address": 1}})
+	require.ErrorContains(t, err, "invalid addresses")
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
@@ -49,6 +50,9 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultGraphQLAddress is the default address the GraphQL server binds to.
+	DefaultGraphQLAddress = "127.0.0.1:8547"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -88,9 +92,15 @@ const (
 	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' query
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultTraceBlockRangeCap is the default cap of block range allowed for 'trace_filter' query
+	DefaultTraceBlockRangeCap int32 = 100
+
 	// DefaultEVMTimeout is the default timeout for eth_call
 	DefaultEVMTimeout = 5 * time.Second
 
+	// DefaultSendRawTxSyncTimeout is the default maximum time eth_sendRawTransactionSync waits for the inclusion of a transaction
+	DefaultSendRawTxSyncTimeout = 20 * time.Second
+
 	// DefaultTxFeeCap is the default tx-fee cap for sending a transaction
 	DefaultTxFeeCap float64 = 1.0
 
@@ -122,6 +132,22 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// MinAuthSecretLength is the minimum length in bytes of the HMAC secret of a JSON-RPC API key
+	MinAuthSecretLength = 32
+
+	// InternalAuthKeyID is the id of the key used by the WebSocket server to forward the requests to the
+	// HTTP server, reserved for it
+	InternalAuthKeyID = "internal"
+
+	// DefaultRateLimit is the default request cost units per second allowed for each client (disabled = 0)
+	DefaultRateLimit float64 = 0
+
+	// DefaultRateLimitBurst is the default maximum request cost units a client can spend at once
+	DefaultRateLimitBurst = 100
+
+	// DefaultResponseCacheSize is the default number of cached responses of each JSON-RPC query (disabled = 0)
+	DefaultResponseCacheSize = 0
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -214,7 +240,8 @@ func (c MempoolConfig) Validate() error {
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
 type JSONRPCConfig struct {
-	// API defines a list of JSON-RPC namespaces that should be enabled
+	// API defines a list of JSON-RPC namespaces that should be enabled. The admin namespace only adds peers if
+	// the unsafe CometBFT RPC is enabled.
 	API []string `mapstructure:"api"`
 	// Address defines the HTTP server to listen on
 	Address string `mapstructure:"address"`
@@ -226,6 +253,8 @@ type JSONRPCConfig struct {
 	AllowInsecureUnlock bool `mapstructure:"allow-insecure-unlock"`
 	// EVMTimeout is the global timeout for eth-call.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
+	// SendRawTxSyncTimeout is the maximum time eth_sendRawTransactionSync waits for the inclusion of a transaction.
+	SendRawTxSyncTimeout time.Duration `mapstructure:"send-raw-tx-sync-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
 	// FilterCap is the global cap for total number of filters that can be created.
@@ -238,10 +267,16 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// TraceBlockRangeCap defines the max block range allowed for `trace_filter` query,
+	// whose blocks are all traced.
+	TraceBlockRangeCap int32 `mapstructure:"trace-block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// SlowRequestThreshold is the duration from which the requests are logged
+	// as slow, 0 disabling the slow request log.
+	SlowRequestThreshold time.Duration `mapstructure:"slow-request-threshold"`
 	// AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
@@ -254,12 +289,76 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableHistoricalState enables the recording of the EVM state per block,
+	// serving the historical balances, storage and code on pruned nodes.
+	EnableHistoricalState bool `mapstructure:"enable-historical-state"`
+	// EnableCosmosPseudoTxs enables the pseudo transactions and receipts of the
+	// EVM balance changes made by the Cosmos transactions.
+	EnableCosmosPseudoTxs bool `mapstructure:"enable-cosmos-pseudo-txs"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableAuth enables the JWT bearer-token authentication of the HTTP and WebSocket servers.
+	EnableAuth bool `mapstructure:"enable-auth"`
+	// PublicAPI defines the namespaces and methods that can be called without a token when authentication is enabled.
+	PublicAPI []string `mapstructure:"public-api"`
+	// AuthKeys defines the API keys accepted when authentication is enabled.
+	AuthKeys []AuthKeyConfig `mapstructure:"auth-keys"`
+	// RateLimit defines the request cost units per second allowed for each client, identified by its API key
+	// or IP address. Rate limiting is disabled if 0.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the maximum request cost units a client can spend at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// TrustedProxies defines the IP addresses or CIDR ranges of the reverse proxies whose
+	// X-Forwarded-For header identifies the rate limited clients, instead of their own address.
+	TrustedProxies []string `mapstructure:"rate-limit-trusted-proxies"`
+	// MethodCosts defines the cost units of methods or whole namespaces, the other methods cost 1 unit.
+	MethodCosts map[string]int `mapstructure:"method-costs"`
+	// ResponseCacheSize defines the number of blocks, receipts and block logs kept in the in-memory LRU cache of
+	// the responses for committed blocks. The cache is disabled if 0.
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// EnableGraphQL defines if the EIP-1767 GraphQL server should be enabled.
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// GraphQLAddress defines the GraphQL server to listen on.
+	GraphQLAddress string `mapstructure:"graphql-address"`
+}
+
+// AuthKeyConfig defines an API key of the JSON-RPC server. Requests are
+// authenticated with an HS256 JWT signed with the key secret, and can only call
+// the allowed namespaces and methods.
+type AuthKeyConfig struct {
+	// ID identifies the key in the logs and the rate limiter, InternalAuthKeyID being reserved.
+	ID string `mapstructure:"id"`
+	// Secret is the hex encoded HMAC secret of the key, of at least 32 bytes.
+	Secret string `mapstructure:"secret"`
+	// Namespaces defines the namespaces the key can call, "*" allows all of them.
+	Namespaces []string `mapstructure:"namespaces"`
+	// Methods defines the methods the key can call in addition to the namespaces.
+	Methods []string `mapstructure:"methods"`
+}
+
+// Validate returns an error if the API key fields are invalid.
+func (c AuthKeyConfig) Validate() error {
+	if c.ID == "" {
+		return errors.New("API key id cannot be empty")
+	}
+	if c.ID == InternalAuthKeyID {
+		return fmt.Errorf("API key id %s is reserved", c.ID)
+	}
+
+	secret, err := hexutil.Decode(c.Secret)
+	if err != nil {
+		return fmt.Errorf("invalid secret of API key %s: %w", c.ID, err)
+	}
+
+	if len(secret) < MinAuthSecretLength {
+		return fmt.Errorf("secret of API key %s must be at least %d bytes long", c.ID, MinAuthSecretLength)
+	}
+
+	return nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -307,7 +406,22 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "ots", "trace", "cosmos", "admin"}
+}
+
+// GetDefaultMethodCosts returns the default cost units of the expensive
+// JSON-RPC methods and namespaces. The other methods cost 1 unit.
+func GetDefaultMethodCosts() map[string]int {
+	return map[string]int{
+		"eth_call":                 2,
+		"eth_estimateGas":          2,
+		"eth_getLogs":              10,
+		"eth_getFilterLogs":        10,
+		"debug":                    20,
+		"debug_traceBlockByNumber": 50,
+		"debug_traceBlockByHash":   50,
+		"trace":                    50,
+	}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -318,28 +432,43 @@ func GetDefaultWSOrigins() []string {
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:               false,
-		API:                  GetDefaultAPINamespaces(),
-		Address:              DefaultJSONRPCAddress,
-		WsAddress:            DefaultJSONRPCWsAddress,
-		GasCap:               DefaultGasCap,
-		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
-		EVMTimeout:           DefaultEVMTimeout,
-		TxFeeCap:             DefaultTxFeeCap,
-		FilterCap:            DefaultFilterCap,
-		FeeHistoryCap:        DefaultFeeHistoryCap,
-		BlockRangeCap:        DefaultBlockRangeCap,
-		LogsCap:              DefaultLogsCap,
-		HTTPTimeout:          DefaultHTTPTimeout,
-		HTTPIdleTimeout:      DefaultHTTPIdleTimeout,
-		AllowUnprotectedTxs:  DefaultAllowUnprotectedTxs,
-		BatchRequestLimit:    DefaultBatchRequestLimit,
-		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
-		MaxOpenConnections:   DefaultMaxOpenConnections,
-		EnableIndexer:        false,
-		MetricsAddress:       DefaultJSONRPCMetricsAddress,
-		WSOrigins:            GetDefaultWSOrigins(),
-		EnableProfiling:      DefaultEnableProfiling,
+		Enable:                false,
+		API:                   GetDefaultAPINamespaces(),
+		Address:               DefaultJSONRPCAddress,
+		WsAddress:             DefaultJSONRPCWsAddress,
+		GasCap:                DefaultGasCap,
+		AllowInsecureUnlock:   DefaultJSONRPCAllowInsecureUnlock,
+		EVMTimeout:            DefaultEVMTimeout,
+		SendRawTxSyncTimeout:  DefaultSendRawTxSyncTimeout,
+		TxFeeCap:              DefaultTxFeeCap,
+		FilterCap:             DefaultFilterCap,
+		FeeHistoryCap:         DefaultFeeHistoryCap,
+		BlockRangeCap:         DefaultBlockRangeCap,
+		TraceBlockRangeCap:    DefaultTraceBlockRangeCap,
+		LogsCap:               DefaultLogsCap,
+		HTTPTimeout:           DefaultHTTPTimeout,
+		HTTPIdleTimeout:       DefaultHTTPIdleTimeout,
+		SlowRequestThreshold:  0,
+		AllowUnprotectedTxs:   DefaultAllowUnprotectedTxs,
+		BatchRequestLimit:     DefaultBatchRequestLimit,
+		BatchResponseMaxSize:  DefaultBatchResponseMaxSize,
+		MaxOpenConnections:    DefaultMaxOpenConnections,
+		EnableIndexer:         false,
+		EnableHistoricalState: false,
+		EnableCosmosPseudoTxs: false,
+		MetricsAddress:        DefaultJSONRPCMetricsAddress,
+		WSOrigins:             GetDefaultWSOrigins(),
+		EnableProfiling:       DefaultEnableProfiling,
+		EnableAuth:            false,
+		PublicAPI:             []string{},
+		AuthKeys:              []AuthKeyConfig{},
+		RateLimit:             DefaultRateLimit,
+		RateLimitBurst:        DefaultRateLimitBurst,
+		TrustedProxies:        []string{},
+		MethodCosts:           GetDefaultMethodCosts(),
+		ResponseCacheSize:     DefaultResponseCacheSize,
+		EnableGraphQL:         false,
+		GraphQLAddress:        DefaultGraphQLAddress,
 	}
 }
 
@@ -365,6 +494,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.SendRawTxSyncTimeout <= 0 {
+		return errors.New("JSON-RPC send raw tx sync timeout duration must be positive")
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -373,6 +506,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.TraceBlockRangeCap < 0 {
+		return errors.New("JSON-RPC trace block range cap cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -381,6 +518,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.SlowRequestThreshold < 0 {
+		return errors.New("JSON-RPC slow request threshold cannot be negative")
+	}
+
 	if c.BatchRequestLimit < 0 {
 		return errors.New("JSON-RPC batch request limit cannot be negative")
 	}
@@ -389,6 +530,55 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.EnableHistoricalState && !c.EnableIndexer {
+		return errors.New("cannot enable JSON-RPC historical state without the indexer")
+	}
+
+	if c.EnableAuth && len(c.AuthKeys) == 0 && len(c.PublicAPI) == 0 {
+		return errors.New("cannot enable JSON-RPC authentication without defining any API key or public API")
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateLimit > 0 && c.RateLimitBurst <= 0 {
+		return errors.New("JSON-RPC rate limit burst must be positive when rate limiting is enabled")
+	}
+
+	for _, proxy := range c.TrustedProxies {
+		if _, err := netip.ParsePrefix(proxy); err != nil {
+			if _, err := netip.ParseAddr(proxy); err != nil {
+				return fmt.Errorf("invalid JSON-RPC rate limit trusted proxy %s", proxy)
+			}
+		}
+	}
+
+	for method, cost := range c.MethodCosts {
+		if cost < 0 {
+			return fmt.Errorf("JSON-RPC cost of method %s cannot be negative", method)
+		}
+	}
+
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.EnableGraphQL && c.GraphQLAddress == "" {
+		return errors.New("cannot enable the GraphQL server without defining its address")
+	}
+
+	seenKeys := make(map[string]bool)
+	for _, key := range c.AuthKeys {
+		if err := key.Validate(); err != nil {
+			return err
+		}
+		if seenKeys[key.ID] {
+			return fmt.Errorf("repeated API key id '%s'", key.ID)
+		}
+		seenKeys[key.ID] = true
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -446,6 +636,11 @@ func DefaultConfig() *Config {
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	conf := DefaultConfig()
+	// the configured method costs replace the default ones instead of being
+	// merged with them, as viper lowercases their keys
+	if v.IsSet("json-rpc.method-costs") {
+		conf.JSONRPC.MethodCosts = nil
+	}
 	if err := v.Unmarshal(conf); err != nil {
 		return Config{}, fmt.Errorf("error extracting app config: %w", err)
 	}
@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -69,3 +70,98 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCAuthConfig(t *testing.T) {
+	secret := "0x" + strings.Repeat("ab", serverconfig.MinAuthSecretLength)
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(fmt.Sprintf(`
+[json-rpc]
+enable-auth = true
+public-api = ["eth", "net_version"]
+
+[[json-rpc.auth-keys]]
+id = "indexer"
+secret = "%s"
+namespaces = ["debug"]
+methods = ["txpool_content"]
+`, secret))))
+
+	cfg, err := serverconfig.GetConfig(v)
+	require.NoError(t, err)
+	require.True(t, cfg.JSONRPC.EnableAuth)
+	require.Equal(t, []string{"eth", "net_version"}, cfg.JSONRPC.PublicAPI)
+	require.Equal(t, []serverconfig.AuthKeyConfig{{
+		ID:         "indexer",
+		Secret:     secret,
+		Namespaces: []string{"debug"},
+		Methods:    []string{"txpool_content"},
+	}}, cfg.JSONRPC.AuthKeys)
+	require.NoError(t, cfg.JSONRPC.Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		expErr   string
+	}{
+		{"no key nor public API", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthKeys, cfg.PublicAPI = nil, nil
+		}, "without defining any API key"},
+		{"empty key id", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthKeys[0].ID = ""
+		}, "id cannot be empty"},
+		{"reserved key id", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthKeys[0].ID = serverconfig.InternalAuthKeyID
+		}, "is reserved"},
+		{"short secret", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthKeys[0].Secret = "0xabcd"
+		}, "at least 32 bytes"},
+		{"repeated key id", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthKeys = append(cfg.AuthKeys, cfg.AuthKeys[0])
+		}, "repeated API key id"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jsonrpc := cfg.JSONRPC
+			jsonrpc.AuthKeys = append([]serverconfig.AuthKeyConfig{}, cfg.JSONRPC.AuthKeys...)
+			tc.malleate(&jsonrpc)
+			require.ErrorContains(t, jsonrpc.Validate(), tc.expErr)
+		})
+	}
+}
+
+func TestJSONRPCRateLimitConfig(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[json-rpc]
+rate-limit = 5.5
+rate-limit-burst = 20
+rate-limit-trusted-proxies = ["10.0.0.0/8", "127.0.0.1"]
+
+[json-rpc.method-costs]
+eth_getLogs = 10
+debug = 15
+`)))
+
+	cfg, err := serverconfig.GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, 5.5, cfg.JSONRPC.RateLimit)
+	require.Equal(t, 20, cfg.JSONRPC.RateLimitBurst)
+	require.Equal(t, []string{"10.0.0.0/8", "127.0.0.1"}, cfg.JSONRPC.TrustedProxies)
+	// viper lowercases the map keys
+	require.Equal(t, map[string]int{"eth_getlogs": 10, "debug": 15}, cfg.JSONRPC.MethodCosts)
+
+	jsonrpc := cfg.JSONRPC
+	jsonrpc.RateLimitBurst = 0
+	require.ErrorContains(t, jsonrpc.Validate(), "burst must be positive")
+
+	jsonrpc = cfg.JSONRPC
+	jsonrpc.MethodCosts = map[string]int{"eth_call": -1}
+	require.ErrorContains(t, jsonrpc.Validate(), "cannot be negative")
+
+	jsonrpc = cfg.JSONRPC
+	jsonrpc.TrustedProxies = []string{"10.0.0.0/33"}
+	require.ErrorContains(t, jsonrpc.Validate(), "invalid JSON-RPC rate limit trusted proxy")
+}
@@ -72,6 +72,7 @@ ws-address = "{{ .JSONRPC.WsAddress }}"
 ws-origins = [{{range $index, $elmt := .JSONRPC.WSOrigins}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
 
 # API defines a list of JSON-RPC namespaces that should be enabled
+# The admin namespace only adds peers if the unsafe CometBFT RPC is enabled (rpc.unsafe in config.toml).
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
@@ -84,6 +85,10 @@ allow-insecure-unlock = {{ .JSONRPC.AllowInsecureUnlock }}
 # EVMTimeout is the global timeout for eth_call. Default: 5s.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
+# SendRawTxSyncTimeout is the maximum time eth_sendRawTransactionSync waits for the inclusion of a
+# transaction, it should be lower than the HTTP timeout. Default: 20s.
+send-raw-tx-sync-timeout = "{{ .JSONRPC.SendRawTxSyncTimeout }}"
+
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
@@ -99,12 +104,19 @@ logs-cap = {{ .JSONRPC.LogsCap }}
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# TraceBlockRangeCap defines the max block range allowed for 'trace_filter' query, whose blocks are all traced.
+trace-block-range-cap = {{ .JSONRPC.TraceBlockRangeCap }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# SlowRequestThreshold is the duration from which the requests are logged with their method, a hash of their
+# parameters and their duration. Set to 0 to disable the slow request log.
+slow-request-threshold = "{{ .JSONRPC.SlowRequestThreshold }}"
+
 # AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 # the node's RPC when the global parameter is disabled.
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
@@ -116,6 +128,14 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableHistoricalState records the EVM balances, storage and code of each block from the time it is enabled,
+# serving the historical state queries even when the app state is pruned. Requires the indexer.
+enable-historical-state = {{ .JSONRPC.EnableHistoricalState }}
+
+# EnableCosmosPseudoTxs adds to the blocks and receipts pseudo transactions of type 0x7e for the balance changes
+# of the EVM coin made by the Cosmos transactions (e.g. bank sends, staking, IBC transfers).
+enable-cosmos-pseudo-txs = {{ .JSONRPC.EnableCosmosPseudoTxs }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -129,6 +149,57 @@ batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
 # Enabled profiling in the debug namespace
 enable-profiling = {{ .JSONRPC.EnableProfiling }}
 
+# EnableAuth requires the HTTP and WebSocket requests to carry an "Authorization: Bearer <token>" header,
+# where the token is an HS256 JWT signed with the secret of one of the auth-keys, with an "iat" claim
+# within 60 seconds of the node time.
+enable-auth = {{ .JSONRPC.EnableAuth }}
+
+# PublicAPI defines the namespaces and methods that can be called without a token when auth is enabled.
+# Example: ["eth", "net", "web3", "debug_traceTransaction"]
+public-api = [{{range $index, $elmt := .JSONRPC.PublicAPI}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimit defines the request cost units per second allowed for each client, identified by its API key
+# or IP address. Requests over the limit fail with the -32005 "limit exceeded" error (0 = disabled).
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateLimitBurst defines the maximum request cost units a client can spend at once.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# TrustedProxies defines the IP addresses or CIDR ranges of the reverse proxies in front of the node.
+# The requests they forward are limited by the client address of their X-Forwarded-For header, instead of
+# all sharing the bucket of the proxy. Example: ["10.0.0.0/8", "127.0.0.1"]
+rate-limit-trusted-proxies = [{{range $index, $elmt := .JSONRPC.TrustedProxies}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# ResponseCacheSize defines the number of blocks, receipts and block logs kept in the in-memory LRU cache
+# of the responses for committed blocks, which never change once available (0 = disabled).
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
+# EnableGraphQL defines if the EIP-1767 GraphQL server should be enabled.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
+# GraphQLAddress defines the GraphQL server address to bind to.
+graphql-address = "{{ .JSONRPC.GraphQLAddress }}"
+
+# AuthKeys defines the API keys accepted when auth is enabled. Each key has a hex encoded secret of at
+# least 32 bytes, and can only call the listed namespaces ("*" for all of them) and methods. The id
+# "internal" is reserved.
+# Example:
+# [[json-rpc.auth-keys]]
+# id = "indexer"
+# secret = "0x..."
+# namespaces = ["eth", "debug"]
+# methods = ["txpool_content"]
+{{range .JSONRPC.AuthKeys}}
+[[json-rpc.auth-keys]]
+id = "{{ .ID }}"
+secret = "{{ .Secret }}"
+namespaces = [{{range $index, $elmt := .Namespaces}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+methods = [{{range $index, $elmt := .Methods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+{{end}}
+# MethodCosts defines the rate limit cost units of methods or whole namespaces, the other methods cost 1 unit.
+[json-rpc.method-costs]
+{{range $method, $cost := .JSONRPC.MethodCosts}}{{$method}} = {{$cost}}
+{{end}}
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -37,26 +37,39 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable               = "json-rpc.enable"
-	JSONRPCAPI                  = "json-rpc.api"
-	JSONRPCAddress              = "json-rpc.address"
-	JSONWsAddress               = "json-rpc.ws-address"
-	JSONRPCWSOrigins            = "json-rpc.ws-origins"
-	JSONRPCGasCap               = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
-	JSONRPCFilterCap            = "json-rpc.filter-cap"
-	JSONRPCLogsCap              = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
-	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
-	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
-	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnable                = "json-rpc.enable"
+	JSONRPCAPI                   = "json-rpc.api"
+	JSONRPCAddress               = "json-rpc.address"
+	JSONWsAddress                = "json-rpc.ws-address"
+	JSONRPCWSOrigins             = "json-rpc.ws-origins"
+	JSONRPCGasCap                = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock   = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout            = "json-rpc.evm-timeout"
+	JSONRPCSendRawTxSyncTimeout  = "json-rpc.send-raw-tx-sync-timeout"
+	JSONRPCTxFeeCap              = "json-rpc.txfee-cap"
+	JSONRPCFilterCap             = "json-rpc.filter-cap"
+	JSONRPCLogsCap               = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap         = "json-rpc.block-range-cap"
+	JSONRPCTraceBlockRangeCap    = "json-rpc.trace-block-range-cap"
+	JSONRPCHTTPTimeout           = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout       = "json-rpc.http-idle-timeout"
+	JSONRPCSlowRequestThreshold  = "json-rpc.slow-request-threshold"
+	JSONRPCAllowUnprotectedTxs   = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections    = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer         = "json-rpc.enable-indexer"
+	JSONRPCEnableHistoricalState = "json-rpc.enable-historical-state"
+	JSONRPCEnableCosmosPseudoTxs = "json-rpc.enable-cosmos-pseudo-txs"
+	JSONRPCBatchRequestLimit     = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize  = "json-rpc.batch-response-max-size"
+	JSONRPCEnableProfiling       = "json-rpc.enable-profiling"
+	JSONRPCEnableAuth            = "json-rpc.enable-auth"
+	JSONRPCPublicAPI             = "json-rpc.public-api"
+	JSONRPCRateLimit             = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst        = "json-rpc.rate-limit-burst"
+	JSONRPCRateLimitProxies      = "json-rpc.rate-limit-trusted-proxies"
+	JSONRPCResponseCacheSize     = "json-rpc.response-cache-size"
+	JSONRPCEnableGraphQL         = "json-rpc.enable-graphql"
+	JSONRPCGraphQLAddress        = "json-rpc.graphql-address"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -18,11 +18,12 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|reindex]",
 		Short: "Index historical eth txs",
-		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
+		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges, or the reindexing of the indexed blocks:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- reindex: index again the blocks from the first to the latest indexed block, to backfill the address and log indexes of the blocks indexed by older versions.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -36,8 +37,8 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "reindex" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|reindex, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
@@ -110,6 +111,23 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "reindex":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				latest, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					return fmt.Errorf("the indexer db is empty")
+				}
+				for i := first; i <= latest; i++ {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -7,7 +7,7 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -17,6 +17,11 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	"github.com/cosmos/evm/rpc/monitor"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/server/types"
@@ -28,7 +33,7 @@ import (
 const shutdownTimeout = 200 * time.Millisecond
 
 type AppWithPendingTxStream interface {
-	RegisterPendingTxListener(listener func(common.Hash))
+	RegisterPendingTxListener(listener func(*ethtypes.Transaction))
 }
 
 // StartJSONRPC starts the JSON-RPC server
@@ -75,8 +80,17 @@ func StartJSONRPC(
 		}
 	}
 
+	authenticator, err := auth.NewAuthenticator(config.JSONRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter := ratelimit.NewLimiter(config.JSONRPC)
+	rpcMonitor := monitor.NewMonitor(config.JSONRPC, apis, NewSlowRequestLogger(srvCtx.Logger.With("module", "json-rpc")))
+
+	// the monitor is outermost to record the rejected requests
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcMonitor.Handler(authenticator.Handler(limiter.Handler(rpcServer)))).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -131,7 +145,77 @@ func StartJSONRPC(
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
+	evmBackend, err := backend.NewBackend(srvCtx, logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+	if err != nil {
+		return nil, err
+	}
+
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config, authenticator, limiter, rpcMonitor, evmBackend)
 	wsSrv.Start()
+
+	if config.JSONRPC.EnableGraphQL {
+		graphqlHandler, err := graphql.NewHandler(evmBackend, logger, authenticator, limiter)
+		if err != nil {
+			return nil, err
+		}
+		if err := startGraphQL(ctx, srvCtx, g, config, handlerWithCors.Handler(graphqlHandler)); err != nil {
+			return nil, err
+		}
+	}
+
 	return httpSrv, nil
 }
+
+// startGraphQL starts the GraphQL server, stopped with the given context.
+func startGraphQL(
+	ctx context.Context,
+	srvCtx *server.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	handler http.Handler,
+) error {
+	r := mux.NewRouter()
+	r.Handle("/graphql", handler).Methods("GET", "POST")
+
+	graphqlSrv := &http.Server{
+		Addr:              config.JSONRPC.GraphQLAddress,
+		Handler:           r,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(graphqlSrv.Addr, config)
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		srvCtx.Logger.Info("Starting GraphQL server", "address", config.JSONRPC.GraphQLAddress)
+		errCh := make(chan error)
+		go func() {
+			errCh <- graphqlSrv.Serve(ln)
+		}()
+
+		select {
+		case <-ctx.Done():
+			srvCtx.Logger.Info("stopping GraphQL server...", "address", config.JSONRPC.GraphQLAddress, "timeout", shutdownTimeout)
+			ctxShutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := graphqlSrv.Shutdown(ctxShutdown); err != nil {
+				srvCtx.Logger.Error("failed to shutdown GraphQL server", "error", err.Error())
+			}
+			return nil
+		case err := <-errCh:
+			if err == http.ErrServerClosed {
+				return nil
+			}
+
+			srvCtx.Logger.Error("failed to start GraphQL server", "error", err.Error())
+			return err
+		}
+	})
+
+	return nil
+}
@@ -3,6 +3,9 @@ package server
 import (
 	"context"
 	"log/slog"
+	"time"
+
+	"github.com/cosmos/evm/rpc/monitor"
 
 	"cosmossdk.io/log"
 )
@@ -51,3 +54,11 @@ func (h *CustomSlogHandler) WithAttrs(_ []slog.Attr) slog.Handler {
 func (h *CustomSlogHandler) WithGroup(_ string) slog.Handler {
 	return h
 }
+
+// NewSlowRequestLogger returns the function logging the JSON-RPC requests
+// slower than the configured threshold to the Cosmos SDK logger.
+func NewSlowRequestLogger(logger log.Logger) monitor.SlowRequestFunc {
+	return func(method, paramsHash string, duration time.Duration) {
+		logger.Warn("slow JSON-RPC request", "method", method, "params_hash", paramsHash, "duration", duration.String())
+	}
+}
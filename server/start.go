@@ -38,6 +38,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -204,22 +205,35 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
 	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, cosmosevmserverconfig.GetDefaultWSOrigins(), "Defines a list of WebSocket origins that should be allowed to connect")
-	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is airl (0=infinite)")                        //nolint:lll
+	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is airl (0=infinite)")                          //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCSendRawTxSyncTimeout, cosmosevmserverconfig.DefaultSendRawTxSyncTimeout, "Sets the maximum time eth_sendRawTransactionSync waits for the inclusion of a transaction")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCSlowRequestThreshold, 0, "Sets the duration from which the json-rpc requests are logged as slow (0=disabled)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, cosmosevmserverconfig.DefaultBatchRequestLimit, "Maximum number of requests in a batch")
 	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceBlockRangeCap, cosmosevmserverconfig.DefaultTraceBlockRangeCap, "Sets the max block range allowed for `trace_filter` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableHistoricalState, false, "Record the EVM state of each block to serve historical queries on pruned nodes (requires the indexer)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableCosmosPseudoTxs, false, "Add pseudo transactions and receipts for the EVM balance changes made by Cosmos transactions")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAuth, false, "Requires a JWT bearer token signed with one of the configured API keys to call the JSON-RPC server")
+	cmd.Flags().StringSlice(srvflags.JSONRPCPublicAPI, []string{}, "Defines a list of JSON-RPC namespaces and methods that can be called without a token when authentication is enabled") //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, cosmosevmserverconfig.DefaultRateLimit, "Sets the request cost units per second allowed for each JSON-RPC client, 0 disables rate limiting")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, cosmosevmserverconfig.DefaultRateLimitBurst, "Sets the maximum request cost units a JSON-RPC client can spend at once")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitProxies, []string{}, "Defines the addresses or CIDR ranges of the reverse proxies whose X-Forwarded-For header identifies the rate limited JSON-RPC clients") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, cosmosevmserverconfig.DefaultResponseCacheSize, "Sets the number of cached responses of each JSON-RPC query for committed blocks, 0 disables the cache")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, false, "Define if the EIP-1767 GraphQL server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCGraphQLAddress, cosmosevmserverconfig.DefaultGraphQLAddress, "the GraphQL server address to listen on")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -410,17 +424,27 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	genDocProvider := GenDocProvider(cfg)
 
 	var (
-		bftNode  *node.Node
-		gRPCOnly = svrCtx.Viper.GetBool(srvflags.GRPCOnly)
+		bftNode    *node.Node
+		stateStore *indexer.StateStore
+		gRPCOnly   = svrCtx.Viper.GetBool(srvflags.GRPCOnly)
 	)
 
 	if gRPCOnly {
 		logger.Info("starting node in query only mode; CometBFT is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
+		config.JSONRPC.EnableHistoricalState = false
 	} else {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
+		if config.JSONRPC.EnableHistoricalState {
+			stateStore, err = startStateStore(svrCtx, app, home)
+			if err != nil {
+				logger.Error("failed to start evm state store", "error", err.Error())
+				return err
+			}
+		}
+
 		cmtApp := server.NewCometABCIWrapper(app)
 		bftNode, err = node.NewNode(
 			cfg,
@@ -489,6 +513,9 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 		idxLogger := svrCtx.Logger.With("indexer", "evm")
 		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if stateStore != nil {
+			idxer = indexer.NewStateIndexer(idxer, stateStore)
+		}
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -567,6 +594,39 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenStateDB opens the historical evm state db, using the same db backend as the main app
+func OpenStateDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmstate", backendType, dataDir)
+}
+
+// startStateStore opens the historical evm state store and adds it to the
+// ABCI listeners of the app, so that it records the state of the blocks
+// committed from now on.
+func startStateStore(svrCtx *server.Context, app types.Application, home string) (*indexer.StateStore, error) {
+	streamingApp, ok := app.(interface {
+		StreamingManager() storetypes.StreamingManager
+		SetStreamingManager(storetypes.StreamingManager)
+	})
+	if !ok {
+		return nil, fmt.Errorf("historical state requires an app supporting state streaming")
+	}
+
+	stateDB, err := OpenStateDB(home, server.GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		return nil, err
+	}
+	stateStore, err := indexer.NewStateStore(stateDB, svrCtx.Logger.With("indexer", "evm-state"), app.CommitMultiStore())
+	if err != nil {
+		return nil, err
+	}
+
+	manager := streamingApp.StreamingManager()
+	manager.ABCIListeners = append(manager.ABCIListeners, stateStore)
+	streamingApp.SetStreamingManager(manager)
+	return stateStore, nil
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
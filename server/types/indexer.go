@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,4 +19,31 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetByAddress returns the hashes of the txs sent or received by an
+	// address, before or after a block, and whether more txs remain. Only the
+	// top level sender and recipient of the txs are indexed.
+	GetByAddress(address common.Address, blockNumber int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	// GetBySenderAndNonce returns nil if tx not found.
+	GetBySenderAndNonce(common.Address, uint64) (*common.Hash, error)
+
+	// BlocksWithLogs returns the blocks of a range which may contain logs
+	// matching the addresses and topics, and false if the range is not indexed.
+	BlocksWithLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
+}
+
+// HistoricalStateReader is implemented by the indexers also recording the
+// state of the EVM accounts per block, serving the historical reads which
+// would otherwise require the pruned IAVL versions of the app.
+type HistoricalStateReader interface {
+	// HistoricalBalance returns the spendable balance of an address at a
+	// block, in 18 decimals.
+	HistoricalBalance(address common.Address, height int64) (*big.Int, error)
+	// HistoricalStorage returns the value of a storage slot at a block.
+	HistoricalStorage(address common.Address, key common.Hash, height int64) (common.Hash, error)
+	// HistoricalCode returns the code of an address at a block.
+	HistoricalCode(address common.Address, height int64) ([]byte, error)
+	// ModifiedAccounts returns the addresses of the accounts modified by the
+	// blocks after startHeight up to endHeight included.
+	ModifiedAccounts(startHeight, endHeight int64) ([]common.Address, error)
 }
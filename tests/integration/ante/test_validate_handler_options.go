@@ -3,7 +3,7 @@ package ante
 import (
 	"testing"
 
-	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/evm/ante"
@@ -149,7 +149,7 @@ func RunValidateHandlerOptionsTest(t *testing.T, create network.CreateEvmApp, op
 				SigGasConsumer:         ante.SigVerificationGasConsumer,
 				MaxTxGasWanted:         40000000,
 				DynamicFeeChecker:      true,
-				PendingTxListener:      func(hash common.Hash) {},
+				PendingTxListener:      func(*ethtypes.Transaction) {},
 			},
 			true,
 		},
@@ -22,6 +22,8 @@ import (
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
@@ -186,7 +188,101 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				hash, err := idxer.GetBySenderAndNonce(from, 0)
+				require.NoError(t, err)
+				require.Equal(t, &txHash, hash)
+				hash, err = idxer.GetBySenderAndNonce(from, 1)
+				require.NoError(t, err)
+				require.Nil(t, hash)
+
+				for _, address := range []common.Address{from, to} {
+					hashes, more, err := idxer.GetByAddress(address, 0, true, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+					require.False(t, more)
+
+					hashes, _, err = idxer.GetByAddress(address, tc.block.Height, true, 10)
+					require.NoError(t, err)
+					require.Empty(t, hashes)
+
+					hashes, _, err = idxer.GetByAddress(address, 0, false, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+				}
 			}
 		})
 	}
 }
+
+func TestKVIndexerLogIndex(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+	topic1 := common.BigToHash(big.NewInt(1))
+	topic2 := common.BigToHash(big.NewInt(2))
+
+	// txResult returns a tx result whose data holds the given logs
+	txResult := func(logs ...*ethtypes.Log) *abci.ExecTxResult {
+		res := &types.MsgEthereumTxResponse{Logs: types.NewLogsFromEth(logs)}
+		data, err := encodingConfig.Codec.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+		require.NoError(t, err)
+		return &abci.ExecTxResult{Data: data}
+	}
+	blocks := map[int64][]*abci.ExecTxResult{
+		10: {txResult(&ethtypes.Log{Address: addr1, Topics: []common.Hash{topic1}})},
+		11: {},
+		12: {txResult(&ethtypes.Log{Address: addr2, Topics: []common.Hash{topic1, topic2}})},
+		13: {txResult(&ethtypes.Log{Address: addr1, Topics: []common.Hash{topic2}}), txResult()},
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	_, ok, err := idxer.BlocksWithLogs(10, 13, nil, nil)
+	require.NoError(t, err)
+	require.False(t, ok, "empty log index")
+
+	for height := int64(11); height <= 13; height++ {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, blocks[height]))
+	}
+	_, ok, err = idxer.BlocksWithLogs(10, 13, nil, nil)
+	require.NoError(t, err)
+	require.False(t, ok, "range not covered")
+
+	// index backward
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 10}}, blocks[10]))
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		exp       []int64
+	}{
+		{"all blocks with logs", 10, 13, nil, nil, []int64{10, 12, 13}},
+		{"sub range", 11, 12, nil, nil, []int64{12}},
+		{"address", 10, 13, []common.Address{addr1}, nil, []int64{10, 13}},
+		{"any address", 10, 13, []common.Address{addr1, addr2}, nil, []int64{10, 12, 13}},
+		{"first topic", 10, 13, nil, [][]common.Hash{{topic1}}, []int64{10, 12}},
+		{"wildcard first topic", 10, 13, nil, [][]common.Hash{{}, {topic2}}, []int64{12}},
+		{"address and topic", 10, 13, []common.Address{addr1}, [][]common.Hash{{topic2}}, []int64{13}},
+		{"no match", 10, 13, []common.Address{addr2}, [][]common.Hash{{topic2}}, []int64{}},
+		{"too many topics", 10, 13, nil, [][]common.Hash{{}, {}, {}, {}, {topic1}}, []int64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heights, ok, err := idxer.BlocksWithLogs(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, tc.exp, heights)
+		})
+	}
+
+	// a gap restarts the covered range
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 20}}, nil))
+	_, ok, err = idxer.BlocksWithLogs(10, 13, nil, nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
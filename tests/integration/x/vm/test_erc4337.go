@@ -0,0 +1,148 @@
+package vm
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const erc4337ABI = `[
+	{"type":"function","name":"handleOps","inputs":[{"name":"ops","type":"tuple[]","components":[
+		{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+		{"name":"callData","type":"bytes"},{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+		{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+		{"name":"beneficiary","type":"address"}],"outputs":[]},
+	{"type":"function","name":"getUserOpHash","inputs":[{"name":"userOp","type":"tuple","components":[
+		{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+		{"name":"callData","type":"bytes"},{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+		{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]}],
+		"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"createAccount","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"getAddress","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"execute","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"owner","inputs":[],"outputs":[{"name":"","type":"address"}]}
+]`
+
+// packedUserOperation is the PackedUserOperation of the EntryPoint v0.7.
+type packedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+// testAccountFactoryCode is the runtime code of a minimal account factory:
+// createAccount(address,uint256) deploys with CREATE2 an account for the owner
+// and salt, and getAddress(address,uint256) returns its address. The accounts
+// validate the ECDSA signature of the owner over the EIP-191 hash of the user
+// operation hash, and execute(address,uint256,bytes) calls from the EntryPoint
+// or the owner.
+const testAccountFactoryCode = "0x60003560e01c80635fbfb9cf146100825780638cb84e181461002057600080fd5b61019661013160003960043573ffffffffffffffffffffffffffffffffffffffff16610196526101b66000206040526024356020523060005260ff600b536055600b2073ffffffffffffffffffffffffffffffffffffffff1660005260206000f35b61019661013160003960043573ffffffffffffffffffffffffffffffffffffffff16610196526101b66000206040526024356020523060005260ff600b536055600b2073ffffffffffffffffffffffffffffffffffffffff16803b156100eb5760005260206000f35b5061019661013160003960043573ffffffffffffffffffffffffffffffffffffffff16610196526024356101b660006000f5801561012c5760005260206000f35b600080fd60206020380360003960005160005561017a8061001c6000396000f3361561003c5760003560e01c806319822f7c146100bd578063b61d27f6146100685780638da5cb5b1461003e578063b0d691fe1461004a57600080fd5b005b60005460005260206000f35b730000000071727de22e5e9d8baf0edac6f37da03260005260206000f35b33730000000071727de22e5e9d8baf0edac6f37da0321433600054141761008e57600080fd5b60443560040180358082602001600037600060008260006024356004355af16100bb573d6000803e3d6000fd5b005b33730000000071727de22e5e9d8baf0edac6f37da032146100dd57600080fd5b600435600401806101000135017f19457468657265756d205369676e6564204d6573736167653a0a333200000000600052602435601c52603c600020608052806020013560c052806040013560e052806060013560f81c60a0526000600052602060006080608060015afa5060005180151590600054141690356041141615604435801561017057600080808084335af1505b5060005260206000f3"
+
+// TestERC4337HandleOps tests that a user operation of a deployed account is
+// executed by the preinstalled EntryPoint.
+func (s *KeeperTestSuite) TestERC4337HandleOps() {
+	s.SetupTest()
+
+	contractABI, err := abi.JSON(strings.NewReader(erc4337ABI))
+	s.Require().NoError(err)
+
+	entryPoint := common.HexToAddress(evmtypes.EntryPointV07Address)
+	s.Require().NotEmpty(s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), entryPoint)))
+
+	bundler := s.Keyring.GetKey(0)
+	ownerKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	salt := big.NewInt(7)
+
+	call := func(to common.Address, method string, args ...interface{}) []interface{} {
+		data, err := contractABI.Pack(method, args...)
+		s.Require().NoError(err)
+		res, err := s.Network.App.GetEVMKeeper().CallEVMWithData(s.Network.GetContext(), bundler.Addr, &to, data, false, nil)
+		s.Require().NoError(err)
+		s.Require().False(res.Failed(), res.VmError)
+		out, err := contractABI.Unpack(method, res.Ret)
+		s.Require().NoError(err)
+		return out
+	}
+
+	// the init code returns the runtime code appended to it
+	initCode := append(common.FromHex("0x6102c880600c6000396000f3"), common.FromHex(testAccountFactoryCode)...)
+	factory, err := s.Factory.DeployContract(
+		bundler.Priv,
+		evmtypes.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{Contract: evmtypes.CompiledContract{ABI: contractABI, Bin: initCode}},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the account is deployed and funded before its first user operation, as
+	// the SenderCreator deploying the accounts from the init code isn't
+	// preinstalled
+	sender := call(factory, "getAddress", owner, salt)[0].(common.Address)
+	_, err = s.Factory.ExecuteContractCall(
+		bundler.Priv,
+		evmtypes.EvmTxArgs{To: &factory},
+		testutiltypes.CallArgs{ContractABI: contractABI, MethodName: "createAccount", Args: []interface{}{owner, salt}},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+	_, err = s.Factory.ExecuteEthTx(bundler.Priv, evmtypes.EvmTxArgs{
+		To:     &sender,
+		Amount: big.NewInt(1e18),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+	s.Require().Equal(owner, call(sender, "owner")[0].(common.Address))
+
+	execute, err := contractABI.Pack("execute", recipient, big.NewInt(1000), []byte{})
+	s.Require().NoError(err)
+
+	op := packedUserOperation{
+		Sender:             sender,
+		Nonce:              big.NewInt(0),
+		InitCode:           []byte{},
+		CallData:           execute,
+		PreVerificationGas: big.NewInt(50_000),
+		PaymasterAndData:   []byte{},
+	}
+	// verification and call gas limits, max priority fee and max fee per gas
+	copy(op.AccountGasLimits[:16], common.LeftPadBytes(big.NewInt(1_000_000).Bytes(), 16))
+	copy(op.AccountGasLimits[16:], common.LeftPadBytes(big.NewInt(100_000).Bytes(), 16))
+	copy(op.GasFees[:16], common.LeftPadBytes(big.NewInt(1e9).Bytes(), 16))
+	copy(op.GasFees[16:], common.LeftPadBytes(big.NewInt(1e9).Bytes(), 16))
+
+	userOpHash := call(entryPoint, "getUserOpHash", op)[0].([32]byte)
+	op.Signature, err = crypto.Sign(accounts.TextHash(userOpHash[:]), ownerKey)
+	s.Require().NoError(err)
+	op.Signature[crypto.RecoveryIDOffset] += 27
+
+	_, err = s.Factory.ExecuteContractCall(
+		bundler.Priv,
+		evmtypes.EvmTxArgs{To: &entryPoint, GasLimit: 3_000_000},
+		testutiltypes.CallArgs{
+			ContractABI: contractABI,
+			MethodName:  "handleOps",
+			Args:        []interface{}{[]packedUserOperation{op}, bundler.Addr},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the account executed the call
+	s.Require().Equal(uint64(1000), s.Network.App.GetEVMKeeper().GetBalance(s.Network.GetContext(), recipient).Uint64())
+}
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -322,6 +324,123 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	newIndex := s.Keyring.AddKey()
+	addr := s.Keyring.GetAddr(newIndex)
+
+	keys := []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2"), common.HexToHash("0x3")}
+	for i, key := range keys {
+		s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, []byte{byte(i + 1)})
+	}
+
+	testCases := []struct {
+		msg        string
+		req        *types.QueryStorageRangeRequest
+		expPass    bool
+		expStorage []types.State
+		expNextKey []byte
+	}{
+		{
+			"invalid address",
+			&types.QueryStorageRangeRequest{Address: invalidAddress, MaxResult: 1},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"too many results",
+			&types.QueryStorageRangeRequest{Address: addr.String(), MaxResult: 10_001},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"first page",
+			&types.QueryStorageRangeRequest{Address: addr.String(), MaxResult: 2},
+			true,
+			[]types.State{
+				types.NewState(keys[0], common.BytesToHash([]byte{1})),
+				types.NewState(keys[1], common.BytesToHash([]byte{2})),
+			},
+			keys[2].Bytes(),
+		},
+		{
+			"last page",
+			&types.QueryStorageRangeRequest{Address: addr.String(), KeyStart: keys[2].Bytes(), MaxResult: 2},
+			true,
+			[]types.State{types.NewState(keys[2], common.BytesToHash([]byte{3}))},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := s.Network.GetEvmClient().StorageRange(s.Network.GetContext(), tc.req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStorage, res.Storage)
+			s.Require().Equal(tc.expNextKey, res.NextKey)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRange() {
+	newIndex := s.Keyring.AddKey()
+	addr := s.Keyring.GetAddr(newIndex)
+	key := common.HexToHash("0x1")
+	s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, []byte{1})
+	s.Network.App.GetEVMKeeper().SetCode(s.Network.GetContext(), crypto.Keccak256([]byte{0x60}), []byte{0x60})
+	s.Require().NoError(s.Network.App.GetEVMKeeper().SetAccount(s.Network.GetContext(), addr, statedb.Account{
+		Balance:  uint256.NewInt(0),
+		CodeHash: crypto.Keccak256([]byte{0x60}),
+	}))
+
+	ctx := s.Network.GetContext()
+	client := s.Network.GetEvmClient()
+
+	// iterate over all the accounts one page at a time
+	var (
+		accounts []types.DumpAccount
+		start    []byte
+	)
+	for {
+		res, err := client.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: start, MaxResults: 2})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Accounts), 2)
+		accounts = append(accounts, res.Accounts...)
+		if len(res.Next) == 0 {
+			break
+		}
+		start = res.Next
+	}
+
+	var found *types.DumpAccount
+	for i, account := range accounts {
+		if i > 0 {
+			prev := common.HexToAddress(accounts[i-1].Address)
+			s.Require().Negative(bytes.Compare(prev.Bytes(), common.HexToAddress(account.Address).Bytes()), "accounts must be in address order")
+		}
+		if account.Address == addr.Hex() {
+			found = &accounts[i]
+		}
+	}
+	s.Require().NotNil(found)
+	s.Require().Equal([]byte{0x60}, found.Code)
+	s.Require().Equal([]types.State{types.NewState(key, common.BytesToHash([]byte{1}))}, found.Storage)
+
+	// skip code and storage
+	res, err := client.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: addr.Bytes(), MaxResults: 1, NoCode: true, NoStorage: true})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 1)
+	s.Require().Equal(addr.Hex(), res.Accounts[0].Address)
+	s.Require().Empty(res.Accounts[0].Code)
+	s.Require().Empty(res.Accounts[0].Storage)
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -1933,6 +2052,83 @@ func (s *KeeperTestSuite) TestTraceCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceCallMany() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := s.Keyring.GetAddr(1)
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, big.NewInt(1000))
+	s.Require().NoError(err)
+	deployData := hexutil.Bytes(append(erc20Contract.Bin, ctorArgs...))
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+	contractAddr := crypto.CreateAddress(sender, nonce)
+
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(10))
+	s.Require().NoError(err)
+	balanceOfData, err := erc20Contract.ABI.Pack("balanceOf", recipient)
+	s.Require().NoError(err)
+
+	funded := tx.GenerateAddress()
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	value := (*hexutil.Big)(big.NewInt(1000))
+	overrides, err := json.Marshal(rpctypes.StateOverride{
+		funded: rpctypes.OverrideAccount{Balance: &balance},
+	})
+	s.Require().NoError(err)
+
+	var calls [][]byte
+	for _, args := range []types.TransactionArgs{
+		{From: &sender, Data: &deployData},
+		{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)},
+		{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&balanceOfData)},
+		{From: &funded, To: &recipient, Value: value},
+	} {
+		bz, err := json.Marshal(args)
+		s.Require().NoError(err)
+		calls = append(calls, bz)
+	}
+
+	ctx := s.Network.GetContext()
+	res, err := s.Network.GetEvmClient().TraceCall(ctx, &types.QueryTraceCallRequest{
+		Calls:           calls,
+		StateOverrides:  overrides,
+		TraceConfig:     &types.TraceConfig{Tracer: "callTracer"},
+		BlockNumber:     ctx.BlockHeight(),
+		BlockTime:       ctx.BlockTime(),
+		BlockHash:       common.BytesToHash(ctx.HeaderHash()).Hex(),
+		ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		ChainId:         s.Network.GetEIP155ChainID().Int64(),
+		GasCap:          config.DefaultGasCap,
+	})
+	s.Require().NoError(err)
+
+	var traces []struct {
+		Type   string        `json:"type"`
+		To     string        `json:"to"`
+		Output hexutil.Bytes `json:"output"`
+		Error  string        `json:"error"`
+	}
+	s.Require().NoError(json.Unmarshal(res.Data, &traces))
+	s.Require().Len(traces, 4)
+
+	s.Require().Equal("CREATE", traces[0].Type)
+	s.Require().True(strings.EqualFold(contractAddr.Hex(), traces[0].To))
+	for _, trace := range traces {
+		s.Require().Empty(trace.Error)
+	}
+	// the balance query sees the transfer of the previous call
+	s.Require().Equal(common.LeftPadBytes([]byte{10}, 32), []byte(traces[2].Output))
+
+	// the traced calls are not persisted
+	s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender))
+	s.Require().Nil(s.Network.App.GetEVMKeeper().GetAccount(s.Network.GetContext(), contractAddr))
+	s.Require().Nil(s.Network.App.GetEVMKeeper().GetAccount(s.Network.GetContext(), funded))
+}
+
 func (s *KeeperTestSuite) TestNonceInQuery() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -2211,6 +2407,102 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := s.Keyring.GetAddr(1)
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	deployData := hexutil.Bytes(append(erc20Contract.Bin, ctorArgs...))
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+	contractAddr := crypto.CreateAddress(sender, nonce)
+
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(10))
+	s.Require().NoError(err)
+	overTransferData, err := erc20Contract.ABI.Pack("transfer", recipient, new(big.Int).Add(supply, big.NewInt(1)))
+	s.Require().NoError(err)
+
+	funded := tx.GenerateAddress()
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	value := (*hexutil.Big)(big.NewInt(1000))
+
+	opts := rpctypes.SimOpts{
+		TraceTransfers: true,
+		BlockStateCalls: []rpctypes.SimBlock{
+			{
+				StateOverrides: &rpctypes.StateOverride{
+					funded: rpctypes.OverrideAccount{Balance: &balance},
+				},
+				Calls: []types.TransactionArgs{
+					{From: &sender, Data: &deployData},
+					{From: &funded, To: &recipient, Value: value},
+				},
+			},
+			{
+				Calls: []types.TransactionArgs{
+					{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)},
+					{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&overTransferData)},
+				},
+			},
+		},
+	}
+	bz, err := json.Marshal(&opts)
+	s.Require().NoError(err)
+
+	res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+		Opts:   bz,
+		GasCap: config.DefaultGasCap,
+	})
+	s.Require().NoError(err)
+
+	var blocks []rpctypes.SimBlockResult
+	s.Require().NoError(json.Unmarshal(res.Data, &blocks))
+	s.Require().Len(blocks, 2)
+
+	height := uint64(s.Network.GetContext().BlockHeight()) //nolint:gosec // G115
+	s.Require().Equal(height+1, uint64(blocks[0].Number))
+	s.Require().Equal(height+2, uint64(blocks[1].Number))
+	s.Require().Equal(blocks[0].Hash, blocks[1].ParentHash)
+
+	// contract deployment and native transfer traced as a Transfer log
+	s.Require().Len(blocks[0].Calls, 2)
+	s.Require().Equal(uint64(1), uint64(blocks[0].Calls[0].Status))
+	s.Require().Equal(uint64(1), uint64(blocks[0].Calls[1].Status))
+	s.Require().Len(blocks[0].Calls[1].Logs, 1)
+	s.Require().Equal(rpctypes.SimulateTransferAddress, blocks[0].Calls[1].Logs[0].Address)
+	s.Require().Equal(value.ToInt(), new(big.Int).SetBytes(blocks[0].Calls[1].Logs[0].Data))
+
+	// the second block sees the contract deployed in the first one
+	s.Require().Len(blocks[1].Calls, 2)
+	s.Require().Equal(uint64(1), uint64(blocks[1].Calls[0].Status))
+	s.Require().Len(blocks[1].Calls[0].Logs, 1)
+	s.Require().Equal(contractAddr, blocks[1].Calls[0].Logs[0].Address)
+
+	// reverted call
+	s.Require().Equal(uint64(0), uint64(blocks[1].Calls[1].Status))
+	s.Require().NotNil(blocks[1].Calls[1].Error)
+	s.Require().Equal(rpctypes.SimulateErrCodeReverted, blocks[1].Calls[1].Error.Code)
+	s.Require().Empty(blocks[1].Calls[1].Logs)
+
+	// the simulation does not modify the state
+	s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), contractAddr)))
+	s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender))
+
+	// the gas cap is the budget of the whole simulation: the deployment runs
+	// out of gas and consumes it
+	_, err = s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+		Opts:   bz,
+		GasCap: 300_000,
+	})
+	s.Require().ErrorContains(err, "exceeds the gas cap of the simulation")
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -39,6 +39,12 @@ func GetQueryCmd() *cobra.Command {
 		Bech32ToHexCmd(),
 		GetBankBalanceCmd(),
 		GetERC20BalanceCmd(),
+		GetContractABIsCmd(),
+		GetContractABICmd(),
+		GetForkSchedulesCmd(),
+		GetCodeMetadataCmd(),
+		GetContractCreationCmd(),
+		GetPreinstallsCmd(),
 	)
 	return cmd
 }
@@ -185,6 +191,215 @@ func GetParamsCmd() *cobra.Command {
 	return cmd
 }
 
+// GetContractABIsCmd queries all the registered contract ABIs
+func GetContractABIsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-abis",
+		Short: "Gets all the registered contract ABIs",
+		Long:  "Gets all the contract ABIs registered to decode EVM logs into typed events.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractABIs(cmd.Context(), &types.QueryContractABIsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-abis")
+	return cmd
+}
+
+// GetContractABICmd queries the registered ABI of a contract
+func GetContractABICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-abi ADDRESS",
+		Short: "Gets the registered ABI of a contract",
+		Long:  "Gets the ABI registered to decode the logs of a contract into typed events.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractABI(cmd.Context(), &types.QueryContractABIRequest{
+				Address: address,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetForkSchedulesCmd queries the Ethereum hard forks scheduled through governance
+func GetForkSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fork-schedules",
+		Short: "Gets the scheduled Ethereum hard forks",
+		Long:  "Gets the Ethereum hard forks and their activation times scheduled through governance.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ForkSchedules(cmd.Context(), &types.QueryForkSchedulesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCodeMetadataCmd queries the verified deployment metadata of a code
+func GetCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-metadata ADDRESS_OR_CODE_HASH",
+		Short: "Gets the verified deployment metadata of a contract code",
+		Long:  "Gets the verified deployment metadata of the code of a contract address or of a 32 bytes code hash.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCodeMetadataRequest{}
+			if bz, err := hexutil.Decode(args[0]); err == nil && len(bz) == common.HashLength {
+				req.CodeHash = args[0]
+			} else {
+				if req.Address, err = accountToHex(args[0]); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.CodeMetadata(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetContractCreationCmd queries the creator and creation transaction of a contract
+func GetContractCreationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-creation ADDRESS",
+		Short: "Gets the creator and the creation transaction of a contract",
+		Long:  "Gets the creator and the creation transaction of a contract address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryContractCreationRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.ContractCreation(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPreinstallsCmd queries the installed preinstalls
+func GetPreinstallsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preinstalls",
+		Short: "Gets all the installed preinstalls",
+		Long:  "Gets all the installed preinstalled contracts together with their version, source and code hash.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Preinstalls(cmd.Context(), &types.QueryPreinstallsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "preinstalls")
+	return cmd
+}
+
 // GetConfigCmd queries the evm configuration
 func GetConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,6 +39,7 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		NewRawTxCmd(),
 		NewSendTxCmd(ac),
+		NewRegisterCodeMetadataCmd(),
 	)
 	return txCmd
 }
@@ -180,3 +181,116 @@ When using '--dry-run' a key name cannot be used, only an 0x or bech32 address.
 
 	return cmd
 }
+
+// Flags of the register-code-metadata command
+const (
+	flagCompilerVersion = "compiler-version"
+	flagABI             = "abi"
+	flagSourceHash      = "source-hash"
+	flagCreationCode    = "creation-bytecode"
+	flagConstructorArgs = "constructor-args"
+)
+
+// NewRegisterCodeMetadataCmd returns a CLI command handler for creating a
+// MsgRegisterCodeMetadata transaction.
+func NewRegisterCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-code-metadata [contract_address] [runtime_bytecode_file]",
+		Short: "Register the verified deployment metadata of a contract code",
+		Long: `Register the deployment metadata of the code of a deployed contract.
+The runtime bytecode file must contain the hex encoded deployed bytecode produced
+by the compiler, which is checked against the code stored for the contract.
+When the creation of the contract is recorded, the creation bytecode file and the
+hex encoded constructor arguments are required and checked against the init code
+of the creation.
+`,
+		Example: "evmd tx evm register-code-metadata 0xA2A8B87390F8F2D188242656BFb6852914073D06 ./Token.bin-runtime --creation-bytecode ./Token.bin --constructor-args 0x000000000000000000000000000000000000000000000000000000000000002a --compiler-version 0.8.28 --abi ./Token.abi --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bytecode, err := readBytecodeFile(args[1])
+			if err != nil {
+				return errors.Wrap(err, "failed to read runtime bytecode")
+			}
+
+			msg := &types.MsgRegisterCodeMetadata{
+				Sender:          clientCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+				RuntimeBytecode: bytecode,
+			}
+
+			if msg.CompilerVersion, err = cmd.Flags().GetString(flagCompilerVersion); err != nil {
+				return err
+			}
+			if msg.SourceHash, err = cmd.Flags().GetString(flagSourceHash); err != nil {
+				return err
+			}
+
+			creationFile, err := cmd.Flags().GetString(flagCreationCode)
+			if err != nil {
+				return err
+			}
+			if creationFile != "" {
+				if msg.CreationBytecode, err = readBytecodeFile(creationFile); err != nil {
+					return errors.Wrap(err, "failed to read creation bytecode")
+				}
+			}
+
+			constructorArgs, err := cmd.Flags().GetString(flagConstructorArgs)
+			if err != nil {
+				return err
+			}
+			if constructorArgs != "" {
+				if msg.ConstructorArguments, err = hexutil.Decode(constructorArgs); err != nil {
+					return errors.Wrap(err, "failed to decode constructor arguments")
+				}
+			}
+
+			abiFile, err := cmd.Flags().GetString(flagABI)
+			if err != nil {
+				return err
+			}
+			if abiFile != "" {
+				abiJSON, err := os.ReadFile(abiFile)
+				if err != nil {
+					return errors.Wrap(err, "failed to read abi file")
+				}
+				msg.Abi = string(abiJSON)
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagCompilerVersion, "", "version of the compiler used to build the code")
+	cmd.Flags().String(flagABI, "", "path to the JSON encoded contract ABI")
+	cmd.Flags().String(flagSourceHash, "", "hex encoded hash of the verified source bundle")
+	cmd.Flags().String(flagCreationCode, "", "path to the hex encoded creation bytecode produced by the compiler")
+	cmd.Flags().String(flagConstructorArgs, "", "hex encoded ABI encoded constructor arguments of the contract creation")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readBytecodeFile reads a file containing hex encoded bytecode, with or
+// without the 0x prefix.
+func readBytecodeFile(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(string(bz))
+	if !strings.HasPrefix(code, "0x") {
+		code = "0x" + code
+	}
+	return hexutil.Decode(code)
+}
@@ -73,6 +73,29 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	for _, preinstall := range data.InstalledPreinstalls {
+		k.SetPreinstall(ctx, preinstall)
+	}
+
+	if err := k.AddContractABIs(ctx, data.ContractABIs); err != nil {
+		panic(fmt.Errorf("error adding contract abis: %s", err))
+	}
+
+	for _, schedule := range data.ForkSchedules {
+		k.SetForkSchedule(ctx, schedule)
+	}
+	if err := k.ApplyForkSchedules(ctx); err != nil {
+		panic(fmt.Errorf("error applying fork schedules: %s", err))
+	}
+
+	for _, metadata := range data.CodeMetadata {
+		k.SetCodeMetadata(ctx, metadata)
+	}
+
+	for _, creation := range data.ContractCreations {
+		k.SetContractCreation(ctx, creation)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -93,7 +116,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:             ethGenAccounts,
+		Params:               k.GetParams(ctx),
+		ContractABIs:         k.GetContractABIs(ctx),
+		ForkSchedules:        k.GetForkSchedules(ctx),
+		CodeMetadata:         k.GetAllCodeMetadata(ctx),
+		ContractCreations:    k.GetContractCreations(ctx),
+		InstalledPreinstalls: k.GetPreinstalls(ctx),
 	}
 }
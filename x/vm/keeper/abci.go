@@ -32,6 +32,12 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 		})
 	}
 
+	// apply the hard forks scheduled through governance before any
+	// transaction of the block is executed
+	if err := k.ApplyForkSchedules(ctx); err != nil {
+		return err
+	}
+
 	k.SetHeaderHash(ctx)
 	return nil
 }
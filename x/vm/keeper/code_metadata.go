@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCodeMetadata returns the registered metadata of the given code hash.
+func (k Keeper) GetCodeMetadata(ctx sdk.Context, codeHash common.Hash) (types.CodeMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CodeMetadataKey(codeHash))
+	if len(bz) == 0 {
+		return types.CodeMetadata{}, false
+	}
+
+	var metadata types.CodeMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetCodeMetadata stores the metadata of a code.
+func (k Keeper) SetCodeMetadata(ctx sdk.Context, metadata types.CodeMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&metadata)
+	store.Set(types.CodeMetadataKey(common.HexToHash(metadata.CodeHash)), bz)
+}
+
+// GetAllCodeMetadata returns the metadata of all the registered codes.
+func (k Keeper) GetAllCodeMetadata(ctx sdk.Context) []types.CodeMetadata {
+	metadata := []types.CodeMetadata{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCodeMetadata)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.CodeMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &m)
+		metadata = append(metadata, m)
+	}
+
+	return metadata
+}
+
+// VerifyContractCode checks that the given runtime bytecode matches the code
+// stored for the contract and returns its code hash. When the creation of the
+// contract is recorded, the creation bytecode followed by the constructor
+// arguments must also match the init code of the creation, whose record is
+// returned. The record is nil if the contract has no recorded creation, e.g.
+// a genesis contract, in which case only the runtime bytecode is checked.
+func (k *Keeper) VerifyContractCode(
+	ctx sdk.Context,
+	address common.Address,
+	runtimeBytecode, creationBytecode, constructorArgs []byte,
+) (common.Hash, *types.ContractCreation, error) {
+	codeHash := k.GetCodeHash(ctx, address)
+	if types.IsEmptyCodeHash(codeHash.Bytes()) {
+		return common.Hash{}, nil, errorsmod.Wrapf(types.ErrInvalidCodeMetadata, "account %s is not a contract", address.Hex())
+	}
+
+	if crypto.Keccak256Hash(runtimeBytecode) != codeHash ||
+		!bytes.Equal(k.GetCode(ctx, codeHash), runtimeBytecode) {
+		return common.Hash{}, nil, errorsmod.Wrapf(types.ErrInvalidCodeMetadata, "runtime bytecode does not match the code of contract %s", address.Hex())
+	}
+
+	creation, found := k.GetContractCreation(ctx, address)
+	if !found || creation.InitCodeHash == "" {
+		if len(creationBytecode) > 0 {
+			return common.Hash{}, nil, errorsmod.Wrapf(types.ErrInvalidCodeMetadata, "creation of contract %s is not recorded", address.Hex())
+		}
+		return codeHash, nil, nil
+	}
+
+	if len(creationBytecode) == 0 {
+		return common.Hash{}, nil, errorsmod.Wrapf(types.ErrInvalidCodeMetadata, "creation bytecode is required to verify contract %s", address.Hex())
+	}
+	if crypto.Keccak256Hash(creationBytecode, constructorArgs) != common.HexToHash(creation.InitCodeHash) {
+		return common.Hash{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidCodeMetadata,
+			"creation bytecode and constructor arguments do not match the init code of contract %s", address.Hex(),
+		)
+	}
+
+	return codeHash, &creation, nil
+}
+
+// canReplaceCodeMetadata returns true if the metadata submitted by the sender
+// can replace the registered metadata of a code. The submitter of the
+// registered metadata and the governance authority can always replace it.
+// Otherwise, metadata verified against the creation of its contract replaces
+// metadata which was not, and the deployer of the contract it was verified
+// against replaces the metadata registered by any other account.
+func (k *Keeper) canReplaceCodeMetadata(existing types.CodeMetadata, sender sdk.AccAddress, creation *types.ContractCreation) bool {
+	if existing.Submitter == sender.String() || k.authority.Equals(sender) {
+		return true
+	}
+	if creation == nil {
+		return false
+	}
+	return !existing.CreationVerified ||
+		common.HexToAddress(creation.Creator) == common.BytesToAddress(sender)
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCodeMetadata() {
+	runtimeCode := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+	creationCode := []byte{0x60, 0x0a, 0x60, 0x0c, 0xf3}
+	constructorArgs := common.LeftPadBytes([]byte{0x2a}, 32)
+	codeHash := crypto.Keccak256Hash(runtimeCode)
+
+	deployer := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000d1").Bytes())
+	squatter := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000e1").Bytes())
+	other := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000e2").Bytes())
+
+	// the contract is created by the deployer, the genesis contract has the
+	// same code but no creation record
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	genesisContract := common.HexToAddress("0x00000000000000000000000000000000000000c2")
+	suite.vmKeeper.SetCode(suite.ctx, codeHash.Bytes(), runtimeCode)
+	suite.vmKeeper.SetCodeHash(suite.ctx, contract.Bytes(), codeHash.Bytes())
+	suite.vmKeeper.SetCodeHash(suite.ctx, genesisContract.Bytes(), codeHash.Bytes())
+	suite.vmKeeper.SetContractCreation(suite.ctx, vmtypes.NewContractCreation(
+		contract, common.BytesToAddress(deployer), common.HexToHash("0x01"), crypto.Keccak256Hash(creationCode, constructorArgs), 1,
+	))
+
+	register := func(sender sdk.AccAddress, address common.Address, creation, args []byte, compilerVersion string) error {
+		_, err := suite.vmKeeper.RegisterCodeMetadata(suite.ctx, &vmtypes.MsgRegisterCodeMetadata{
+			Sender:               sender.String(),
+			ContractAddress:      address.Hex(),
+			RuntimeBytecode:      runtimeCode,
+			CreationBytecode:     creation,
+			ConstructorArguments: args,
+			CompilerVersion:      compilerVersion,
+		})
+		return err
+	}
+	requireMetadata := func(submitter sdk.AccAddress, compilerVersion string, creationVerified bool) {
+		metadata, found := suite.vmKeeper.GetCodeMetadata(suite.ctx, codeHash)
+		suite.Require().True(found)
+		suite.Require().Equal(submitter.String(), metadata.Submitter)
+		suite.Require().Equal(compilerVersion, metadata.CompilerVersion)
+		suite.Require().Equal(creationVerified, metadata.CreationVerified)
+	}
+
+	// the creation of a recorded contract must be verified
+	suite.Require().ErrorContains(register(squatter, contract, nil, nil, "0.8.0"), "creation bytecode is required")
+	suite.Require().ErrorContains(register(squatter, contract, creationCode, nil, "0.8.0"), "do not match the init code")
+
+	// a contract without creation record can only be verified from its runtime
+	// bytecode
+	suite.Require().ErrorContains(register(squatter, genesisContract, creationCode, constructorArgs, "0.8.0"), "is not recorded")
+	suite.Require().NoError(register(squatter, genesisContract, nil, nil, "0.8.0"))
+	requireMetadata(squatter, "0.8.0", false)
+
+	// metadata verified against the creation of a contract replaces it
+	suite.Require().NoError(register(other, contract, creationCode, constructorArgs, "0.8.1"))
+	requireMetadata(other, "0.8.1", true)
+
+	// and cannot be replaced by other accounts anymore
+	suite.Require().ErrorContains(register(squatter, contract, creationCode, constructorArgs, "0.8.2"), "already registered")
+	suite.Require().ErrorContains(register(squatter, genesisContract, nil, nil, "0.8.2"), "already registered")
+
+	// except the deployer of the contract
+	suite.Require().NoError(register(deployer, contract, creationCode, constructorArgs, "0.8.28"))
+	requireMetadata(deployer, "0.8.28", true)
+
+	// or the governance authority
+	authority := sdk.AccAddress("foobar")
+	suite.Require().NoError(register(authority, genesisContract, nil, nil, "0.8.29"))
+	requireMetadata(authority, "0.8.29", false)
+}
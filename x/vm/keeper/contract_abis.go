@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetContractABI returns the registered ABI of the given contract address.
+func (k Keeper) GetContractABI(ctx sdk.Context, address common.Address) (types.ContractABI, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ContractABIKey(address))
+	if len(bz) == 0 {
+		return types.ContractABI{}, false
+	}
+
+	var contractABI types.ContractABI
+	k.cdc.MustUnmarshal(bz, &contractABI)
+	return contractABI, true
+}
+
+// SetContractABI stores the ABI of a contract.
+func (k Keeper) SetContractABI(ctx sdk.Context, contractABI types.ContractABI) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&contractABI)
+	store.Set(types.ContractABIKey(common.HexToAddress(contractABI.Address)), bz)
+}
+
+// DeleteContractABI removes the registered ABI of the given contract address.
+func (k Keeper) DeleteContractABI(ctx sdk.Context, address common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ContractABIKey(address))
+}
+
+// IterateContractABIs iterates over all the registered contract ABIs.
+func (k Keeper) IterateContractABIs(ctx sdk.Context, cb func(contractABI types.ContractABI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixContractABI)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var contractABI types.ContractABI
+		k.cdc.MustUnmarshal(iterator.Value(), &contractABI)
+
+		if cb(contractABI) {
+			break
+		}
+	}
+}
+
+// GetContractABIs returns all the registered contract ABIs.
+func (k Keeper) GetContractABIs(ctx sdk.Context) []types.ContractABI {
+	contractABIs := []types.ContractABI{}
+
+	k.IterateContractABIs(ctx, func(contractABI types.ContractABI) (stop bool) {
+		contractABIs = append(contractABIs, contractABI)
+		return false
+	})
+
+	return contractABIs
+}
+
+// AddContractABIs validates and stores the given contract ABIs. Already
+// registered contracts are overwritten.
+func (k Keeper) AddContractABIs(ctx sdk.Context, contractABIs []types.ContractABI) error {
+	for _, contractABI := range contractABIs {
+		if err := contractABI.Validate(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidContractABI, err.Error())
+		}
+
+		// store the checksummed address so that queries and events use a
+		// canonical representation
+		contractABI.Address = common.HexToAddress(contractABI.Address).Hex()
+		k.SetContractABI(ctx, contractABI)
+	}
+	return nil
+}
+
+// EmitContractABIEvents decodes the logs emitted by registered contracts and
+// emits them as typed Cosmos events. Logs that cannot be decoded are skipped,
+// since a faulty ABI registration must never make a transaction fail.
+//
+// The ABIs are read without gas, the gas used by the transaction being fixed
+// by its Ethereum execution.
+func (k Keeper) EmitContractABIEvents(ctx sdk.Context, logs []*types.Log) {
+	if len(logs) == 0 {
+		return
+	}
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	type registered struct {
+		contractABI types.ContractABI
+		parsed      abi.ABI
+	}
+
+	// cache the parsed ABIs, contracts usually emit more than one log per tx
+	cache := make(map[common.Address]*registered)
+
+	for _, log := range logs {
+		address := common.HexToAddress(log.Address)
+
+		entry, found := cache[address]
+		if !found {
+			if contractABI, ok := k.GetContractABI(ctx, address); ok {
+				parsed, err := contractABI.ParseABI()
+				if err != nil {
+					k.Logger(ctx).Error("failed to parse registered contract abi", "contract", address.Hex(), "error", err.Error())
+				} else {
+					entry = &registered{contractABI: contractABI, parsed: parsed}
+				}
+			}
+			cache[address] = entry
+		}
+
+		if entry == nil {
+			continue
+		}
+
+		event, ok, err := entry.contractABI.DecodeLog(entry.parsed, log)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to decode contract log", "contract", address.Hex(), "error", err.Error())
+			continue
+		}
+		if ok {
+			ctx.EventManager().EmitEvent(event)
+		}
+	}
+}
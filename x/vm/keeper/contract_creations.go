@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetContractCreation returns the deployment record of the given contract.
+func (k Keeper) GetContractCreation(ctx sdk.Context, address common.Address) (types.ContractCreation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ContractCreationKey(address))
+	if len(bz) == 0 {
+		return types.ContractCreation{}, false
+	}
+
+	var creation types.ContractCreation
+	k.cdc.MustUnmarshal(bz, &creation)
+	return creation, true
+}
+
+// SetContractCreation stores the deployment record of a contract.
+func (k Keeper) SetContractCreation(ctx sdk.Context, creation types.ContractCreation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&creation)
+	store.Set(types.ContractCreationKey(common.HexToAddress(creation.Address)), bz)
+}
+
+// GetContractCreations returns the deployment records of all the contracts.
+func (k Keeper) GetContractCreations(ctx sdk.Context) []types.ContractCreation {
+	creations := []types.ContractCreation{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixContractCreation)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var creation types.ContractCreation
+		k.cdc.MustUnmarshal(iterator.Value(), &creation)
+		creations = append(creations, creation)
+	}
+
+	return creations
+}
+
+// contractCreationRecorder collects the contracts created by the CREATE and
+// CREATE2 operations of a transaction, including the ones performed by nested
+// calls (e.g. factory contracts).
+type contractCreationRecorder struct {
+	// frames holds the creation frames being executed, by call depth
+	frames map[int]*creationFrame
+	// created holds the creation frames that succeeded, in order
+	created []*creationFrame
+}
+
+// creationFrame is a CREATE or CREATE2 frame.
+type creationFrame struct {
+	address      common.Address
+	creator      common.Address
+	initCodeHash common.Hash
+}
+
+func newContractCreationRecorder() *contractCreationRecorder {
+	return &contractCreationRecorder{
+		frames: make(map[int]*creationFrame),
+	}
+}
+
+// Hooks returns a copy of the given tracing hooks that additionally records
+// the contract creation frames. A frame is only recorded when it exits
+// without error, as the EVM enters it before checking the call depth, the
+// balance of the creator and the collision with an existing contract.
+func (r *contractCreationRecorder) Hooks(inner *tracing.Hooks) *tracing.Hooks {
+	if inner == nil {
+		inner = types.NewNoOpTracer()
+	}
+
+	hooks := *inner
+	onEnter, onExit := inner.OnEnter, inner.OnExit
+	hooks.OnEnter = func(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+
+		switch vm.OpCode(typ) {
+		case vm.CREATE, vm.CREATE2:
+			// the input of a creation frame is the init code
+			r.frames[depth] = &creationFrame{address: to, creator: from, initCodeHash: crypto.Keccak256Hash(input)}
+		default:
+			delete(r.frames, depth)
+		}
+	}
+	hooks.OnExit = func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+		if onExit != nil {
+			onExit(depth, output, gasUsed, err, reverted)
+		}
+
+		frame := r.frames[depth]
+		delete(r.frames, depth)
+		if frame != nil && err == nil {
+			r.created = append(r.created, frame)
+		}
+	}
+	return &hooks
+}
+
+// recordContractCreations stores the deployment records of the contracts
+// created by the transaction. Creation frames whose parent frame reverted or
+// whose contract self-destructed within the transaction leave no code behind
+// and are skipped. The record of a contract is never overwritten.
+func (k *Keeper) recordContractCreations(ctx sdk.Context, recorder *contractCreationRecorder, txHash common.Hash) {
+	for _, frame := range recorder.created {
+		if types.IsEmptyCodeHash(k.GetCodeHash(ctx, frame.address).Bytes()) {
+			continue
+		}
+		if _, found := k.GetContractCreation(ctx, frame.address); found {
+			continue
+		}
+		k.SetContractCreation(ctx, types.NewContractCreation(
+			frame.address, frame.creator, txHash, frame.initCodeHash, ctx.BlockHeight(),
+		))
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractCreationRecorderCollision(t *testing.T) {
+	// init code deploying the runtime code 0x00
+	initCode := hexutil.MustDecode("0x600060005360016000f3")
+	create2 := "6000600a60166000f550" // pop(create2(0, 22, 10, 0))
+	// the factory deploys the init code twice with the same salt, the second
+	// creation colliding with the first contract
+	factory := hexutil.MustDecode("0x69" + common.Bytes2Hex(initCode) + "600052" + create2 + create2 + "00")
+
+	recorder := newContractCreationRecorder()
+	cfg := &runtime.Config{}
+	cfg.EVMConfig.Tracer = recorder.Hooks(nil)
+	_, _, err := runtime.Execute(factory, nil, cfg)
+	require.NoError(t, err)
+
+	// only the successful creation is recorded
+	factoryAddr := common.BytesToAddress([]byte("contract"))
+	contract := crypto.CreateAddress2(factoryAddr, common.Hash{}, crypto.Keccak256(initCode))
+	require.Equal(t, []*creationFrame{{
+		address:      contract,
+		creator:      factoryAddr,
+		initCodeHash: crypto.Keccak256Hash(initCode),
+	}}, recorder.created)
+	require.Empty(t, recorder.frames)
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetForkSchedule returns the schedule of the given Ethereum hard fork.
+func (k Keeper) GetForkSchedule(ctx sdk.Context, fork string) (types.EVMForkSchedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ForkScheduleKey(fork))
+	if len(bz) == 0 {
+		return types.EVMForkSchedule{}, false
+	}
+
+	var schedule types.EVMForkSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetForkSchedule stores the schedule of an Ethereum hard fork.
+func (k Keeper) SetForkSchedule(ctx sdk.Context, schedule types.EVMForkSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(types.ForkScheduleKey(schedule.Fork), bz)
+}
+
+// DeleteForkSchedule removes the schedule of the given Ethereum hard fork.
+func (k Keeper) DeleteForkSchedule(ctx sdk.Context, fork string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ForkScheduleKey(fork))
+}
+
+// GetForkSchedules returns all the scheduled Ethereum hard forks.
+func (k Keeper) GetForkSchedules(ctx sdk.Context) []types.EVMForkSchedule {
+	schedules := []types.EVMForkSchedule{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixForkSchedule)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.EVMForkSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// ScheduleFork validates and stores the activation time of an Ethereum hard
+// fork. The fork must not be active yet and the activation time must be after
+// the current block time. A pending schedule is replaced by a new schedule of
+// the same fork, or cancelled by a zero activation time. The chain config is
+// updated on the next BeginBlock.
+func (k Keeper) ScheduleFork(ctx sdk.Context, schedule types.EVMForkSchedule) error {
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	if schedule.ActivationTime == 0 {
+		return k.cancelForkSchedule(ctx, schedule.Fork, blockTime)
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	if schedule.ActivationTime <= blockTime {
+		return errorsmod.Wrapf(
+			types.ErrInvalidForkSchedule,
+			"activation time %d of fork %s must be after the current block time %d", schedule.ActivationTime, schedule.Fork, blockTime,
+		)
+	}
+
+	chainConfig := types.GetChainConfig()
+	if chainConfig == nil {
+		return errorsmod.Wrap(types.ErrInvalidChainConfig, "chain config is not set")
+	}
+
+	if ts, ok := chainConfig.ForkActivationTime(schedule.Fork); ok && ts <= blockTime {
+		return errorsmod.Wrapf(types.ErrInvalidForkSchedule, "fork %s is already active since %d", schedule.Fork, ts)
+	}
+
+	// check that the resulting chain config is valid for the compiled
+	// go-ethereum version
+	if _, err := chainConfig.WithForkSchedule(schedule); err != nil {
+		return err
+	}
+
+	k.SetForkSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleEVMFork,
+			sdk.NewAttribute(types.AttributeKeyFork, schedule.Fork),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, strconv.FormatUint(schedule.ActivationTime, 10)),
+		),
+	)
+
+	return nil
+}
+
+// cancelForkSchedule removes the pending schedule of the given fork, which
+// gets back the activation time of the configured chain config on the next
+// BeginBlock.
+func (k Keeper) cancelForkSchedule(ctx sdk.Context, fork string, blockTime uint64) error {
+	if err := types.NewEVMForkSchedule(fork, 0).ValidateFork(); err != nil {
+		return err
+	}
+
+	schedule, found := k.GetForkSchedule(ctx, fork)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidForkSchedule, "fork %s is not scheduled", fork)
+	}
+	if schedule.ActivationTime <= blockTime {
+		return errorsmod.Wrapf(types.ErrInvalidForkSchedule, "fork %s is already active since %d", fork, schedule.ActivationTime)
+	}
+
+	k.DeleteForkSchedule(ctx, fork)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelEVMFork,
+			sdk.NewAttribute(types.AttributeKeyFork, fork),
+		),
+	)
+
+	return nil
+}
+
+// ApplyForkSchedules sets the activation times of the scheduled Ethereum hard
+// forks on the chain config used by the EVM.
+func (k Keeper) ApplyForkSchedules(ctx sdk.Context) error {
+	return types.ApplyForkSchedules(k.GetForkSchedules(ctx))
+}
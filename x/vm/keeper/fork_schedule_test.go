@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func (suite *KeeperTestSuite) TestForkScheduleBeginBlock() {
+	configurator := vmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	suite.Require().NoError(vmtypes.SetChainConfig(vmtypes.DefaultChainConfig(0)))
+	defer configurator.ResetTestConfig()
+
+	suite.fmKeeper.On("GetBaseFee", mock.Anything).Return(sdkmath.LegacyZeroDec()).Maybe()
+	suite.accKeeper.On("GetAccount", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	blockTime := uint64(suite.ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	number := vmtypes.GetEthChainConfig().LondonBlock
+
+	beginBlock := func(time uint64) {
+		suite.Require().NoError(suite.vmKeeper.BeginBlock(suite.ctx))
+		ethCfg := vmtypes.GetEthChainConfig()
+		suite.Require().False(ethCfg.IsOsaka(number, time-1))
+		suite.Require().True(ethCfg.IsOsaka(number, time))
+	}
+
+	// the scheduled fork is active from its activation time after BeginBlock
+	activationTime := blockTime + 10
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(suite.ctx, vmtypes.NewEVMForkSchedule("osaka", activationTime)))
+	suite.Require().Nil(vmtypes.GetEthChainConfig().OsakaTime)
+	beginBlock(activationTime)
+
+	// a pending schedule is replaced
+	activationTime = blockTime + 20
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(suite.ctx, vmtypes.NewEVMForkSchedule("osaka", activationTime)))
+	beginBlock(activationTime)
+
+	// a pending schedule is cancelled, restoring the configured chain config
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(suite.ctx, vmtypes.NewEVMForkSchedule("osaka", 0)))
+	suite.Require().NoError(suite.vmKeeper.BeginBlock(suite.ctx))
+	suite.Require().Nil(vmtypes.GetEthChainConfig().OsakaTime)
+	suite.Require().Empty(suite.vmKeeper.GetForkSchedules(suite.ctx))
+
+	// a fork which is not scheduled cannot be cancelled
+	suite.Require().Error(suite.vmKeeper.ScheduleFork(suite.ctx, vmtypes.NewEVMForkSchedule("osaka", 0)))
+
+	// an active fork cannot be cancelled
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(suite.ctx, vmtypes.NewEVMForkSchedule("osaka", activationTime)))
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30e9))
+	beginBlock(activationTime)
+	suite.Require().Error(suite.vmKeeper.ScheduleFork(suite.ctx, vmtypes.NewEVMForkSchedule("osaka", 0)))
+}
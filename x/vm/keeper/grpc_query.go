@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -28,9 +29,12 @@ import (
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -42,6 +46,11 @@ const (
 	maxTracePredecessors = 10_000
 
 	maxPredecessorGas = uint64(50_000_000)
+
+	// maxStorageRangeResults is the maximum amount of storage slots returned by a StorageRange request.
+	maxStorageRangeResults = 10_000
+	// maxAccountRangeResults is the maximum amount of accounts returned by an AccountRange request.
+	maxAccountRangeResults = 256
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -510,18 +519,61 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		return nil, status.Errorf(codes.InvalidArgument, "too many predecessors, got %d: limit %d", len(req.Predecessors), maxTracePredecessors)
 	}
 
+	ctx, cfg, signer, txConfig, err := k.replayPredecessors(
+		sdk.UnwrapSDKContext(c), req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas, req.ProposerAddress, req.Predecessors,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := req.Msg.AsTransaction()
+	txConfig.TxHash = tx.Hash()
+	if len(req.Predecessors) > 0 {
+		txConfig.TxIndex++
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false)
+	if err != nil {
+		// error will be returned with detail status from traceTx
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceTxResponse{
+		Data: resultData,
+	}, nil
+}
+
+// replayPredecessors returns the context of the beginning of the requested
+// block with the given predecessor transactions of the block applied, along
+// with the EVM configuration, signer and transaction configuration to execute
+// the next transaction of the block.
+func (k *Keeper) replayPredecessors(
+	ctx sdk.Context,
+	blockNumber int64,
+	blockTime time.Time,
+	blockHash string,
+	blockMaxGas int64,
+	proposerAddress sdk.ConsAddress,
+	predecessors []*types.MsgEthereumTx,
+) (sdk.Context, *statedb.EVMConfig, ethtypes.Signer, statedb.TxConfig, error) {
+	var txConfig statedb.TxConfig
+
 	// get the context of block beginning
-	requestedHeight := req.BlockNumber
+	requestedHeight := blockNumber
 	if requestedHeight < 1 {
 		// In Ethereum, the genesis block height is 0, but in CometBFT, the genesis block height is 1.
 		// So here we set the minimum requested height to 1.
 		requestedHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
 	// the caller sets the `ctx.BlockHeight()` to be `requestedHeight - 1`, so we can get the context of block beginning
 	if requestedHeight > ctx.BlockHeight()+1 {
-		return nil, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", requestedHeight, ctx.BlockHeight())
+		return ctx, nil, nil, txConfig, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", requestedHeight, ctx.BlockHeight())
 	}
 	// TODO: ideally, this query should validate that the block hash, predecessor transactions, and main trace tx actually existed in the block requested.
 	// These fields should not be defined by a user.
@@ -529,17 +581,17 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// so that backend isn't using this method over gRPC, but directly.
 	// see: https://linear.app/cosmoslabs/issue/EVM-149/some-xvm-queries-are-meant-to-be-internal
 	ctx = ctx.WithBlockHeight(requestedHeight)
-	ctx = ctx.WithBlockTime(req.BlockTime)
-	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx = ctx.WithBlockTime(blockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(blockHash))
 
 	// to get the base fee we only need the block max gas in the consensus params
 	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
-		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+		Block: &tmproto.BlockParams{MaxGas: blockMaxGas},
 	})
 
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, proposerAddress))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+		return ctx, nil, nil, txConfig, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
 
 	// compute and use base fee of the height that is being traced
@@ -549,7 +601,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}
 
 	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
-	txConfig := statedb.NewEmptyTxConfig()
+	txConfig = statedb.NewEmptyTxConfig()
 
 	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
 	// need to reset gas meter per transaction to be consistent with tx execution
@@ -557,7 +609,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 
 	ctx = evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(storetypes.NewGasMeter(maxPredecessorGas))
-	for i, tx := range req.Predecessors {
+	for i, tx := range predecessors {
 		ethTx := tx.AsTransaction()
 		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
 		if err != nil {
@@ -577,26 +629,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		}
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
-	if len(req.Predecessors) > 0 {
-		txConfig.TxIndex++
-	}
-
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false)
-	if err != nil {
-		// error will be returned with detail status from traceTx
-		return nil, err
-	}
-
-	resultData, err := json.Marshal(result)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	return &types.QueryTraceTxResponse{
-		Data: resultData,
-	}, nil
+	return ctx, cfg, signer, txConfig, nil
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
@@ -675,7 +708,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 // executes the given call in the provided environment. The return value will
 // be tracer dependent.
 func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
-	if req == nil || req.Args == nil {
+	if req == nil || (req.Args == nil && len(req.Calls) == 0) {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
@@ -712,38 +745,96 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 		cfg.BaseFee = baseFee
 	}
 
-	// Get empty tx config
-	txConfig := statedb.NewEmptyTxConfig()
+	// the state overrides and the state changes of the bundled calls are
+	// written to a cache that is never committed
+	ctx, _ = ctx.CacheContext()
+	if len(req.StateOverrides) > 0 {
+		var overrides rpctypes.StateOverride
+		if err := json.Unmarshal(req.StateOverrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := k.applySimulatedStateOverrides(ctx, overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var result interface{}
+	if len(req.Calls) == 0 {
+		// Get empty tx config
+		txConfig := statedb.NewEmptyTxConfig()
+
+		// trace call
+		res, _, err := k.traceCallArgs(ctx, cfg, txConfig, req.Args, req.GasCap, baseFee, req.GetTraceConfig(), false)
+		if err != nil {
+			// error will be returned with detail status from traceTx
+			return nil, err
+		}
+		result = *res
+	} else {
+		txConfig := statedb.NewEmptyTxConfig()
+		results := make([]interface{}, 0, len(req.Calls))
+		for i, call := range req.Calls {
+			txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+			res, logIndex, err := k.traceCallArgs(ctx, cfg, txConfig, call, req.GasCap, baseFee, req.GetTraceConfig(), true)
+			if err != nil {
+				return nil, err
+			}
+			txConfig.LogIndex = logIndex
+			results = append(results, *res)
+		}
+		result = results
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
+// traceCallArgs traces a call given as json encoded transaction args. If
+// commitMessage is set, the state changes of the call are committed and the
+// nonce of the sender is incremented, so that the following calls are traced
+// on top of them.
+func (k *Keeper) traceCallArgs(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	argsBz []byte,
+	gasCap uint64,
+	baseFee *big.Int,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+) (*interface{}, uint, error) {
 	// Get transaction msg from args
 	var args types.TransactionArgs
-	err = json.Unmarshal(req.Args, &args)
+	err := json.Unmarshal(argsBz, &args)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 	// Fill in default values for missing transaction fields (gas, gasPrice, value, etc.)
-	if err := args.CallDefaults(req.GasCap, baseFee, types.GetEthChainConfig().ChainID); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	if err := args.CallDefaults(gasCap, baseFee, types.GetEthChainConfig().ChainID); err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
 	}
 	msg := args.ToMessage(baseFee, true, true)
 
-	// trace call
-	result, _, err := k.traceTxWithMsg(ctx, cfg, txConfig, msg, req.GetTraceConfig(), false)
+	result, logIndex, err := k.traceTxWithMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage)
 	if err != nil {
-		// error will be returned with detail status from traceTx
-		return nil, err
+		return nil, 0, err
 	}
 
-	resultData, err := json.Marshal(result)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if commitMessage {
+		if err := k.finalizeSimulatedMessage(ctx, msg, 0, false); err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
 	}
 
-	return &types.QueryTraceCallResponse{
-		Data: resultData,
-	}, nil
+	return result, logIndex, nil
 }
 
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
@@ -812,9 +903,10 @@ func (k *Keeper) traceTxWithMsg(
 	}
 
 	tCtx := &tracers.Context{
-		BlockHash: common.BytesToHash(ctx.HeaderHash()),
-		TxIndex:   int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
-		TxHash:    txConfig.TxHash,
+		BlockHash:   common.BytesToHash(ctx.HeaderHash()),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
+		TxHash:      txConfig.TxHash,
 	}
 
 	if traceConfig.Tracer != "" {
@@ -886,11 +978,131 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 
 // Config implements the Query/Config gRPC method
 func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
-	config := types.GetChainConfig()
+	// copy the global chain config, which is read concurrently
+	config := *types.GetChainConfig()
 	config.Denom = types.GetEVMCoinDenom()
 	config.Decimals = uint64(types.GetEVMCoinDecimals())
 
-	return &types.QueryConfigResponse{Config: config}, nil
+	return &types.QueryConfigResponse{Config: &config}, nil
+}
+
+// ContractABIs implements the Query/ContractABIs gRPC method. It returns all
+// the registered contract ABIs.
+func (k Keeper) ContractABIs(c context.Context, req *types.QueryContractABIsRequest) (*types.QueryContractABIsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var contractABIs []types.ContractABI
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractABI)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var contractABI types.ContractABI
+		if err := k.cdc.Unmarshal(value, &contractABI); err != nil {
+			return err
+		}
+		contractABIs = append(contractABIs, contractABI)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractABIsResponse{
+		ContractABIs: contractABIs,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// ContractABI implements the Query/ContractABI gRPC method. It returns the
+// registered ABI of the given contract.
+func (k Keeper) ContractABI(c context.Context, req *types.QueryContractABIRequest) (*types.QueryContractABIResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument, err.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+
+	contractABI, found := k.GetContractABI(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no abi registered for contract %s", address.Hex())
+	}
+
+	return &types.QueryContractABIResponse{ContractABI: contractABI}, nil
+}
+
+// ForkSchedules implements the Query/ForkSchedules gRPC method. It returns the
+// Ethereum hard forks scheduled through governance.
+func (k Keeper) ForkSchedules(c context.Context, _ *types.QueryForkSchedulesRequest) (*types.QueryForkSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryForkSchedulesResponse{ForkSchedules: k.GetForkSchedules(ctx)}, nil
+}
+
+// CodeMetadata implements the Query/CodeMetadata gRPC method. It returns the
+// verified deployment metadata of the code of a contract or of a code hash.
+func (k Keeper) CodeMetadata(c context.Context, req *types.QueryCodeMetadataRequest) (*types.QueryCodeMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var codeHash common.Hash
+	switch {
+	case req.Address != "":
+		if err := utils.ValidateAddress(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		codeHash = k.GetCodeHash(ctx, common.HexToAddress(req.Address))
+	case req.CodeHash != "":
+		bz, err := hexutil.Decode(req.CodeHash)
+		if err != nil || len(bz) != common.HashLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid code hash %s", req.CodeHash)
+		}
+		codeHash = common.BytesToHash(bz)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "address or code hash must be provided")
+	}
+
+	metadata, found := k.GetCodeMetadata(ctx, codeHash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no metadata registered for code %s", codeHash.Hex())
+	}
+
+	return &types.QueryCodeMetadataResponse{CodeMetadata: metadata}, nil
+}
+
+// ContractCreation implements the Query/ContractCreation gRPC method. It
+// returns the creator, creation transaction and height of a contract.
+func (k Keeper) ContractCreation(c context.Context, req *types.QueryContractCreationRequest) (*types.QueryContractCreationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument, err.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+
+	creation, found := k.GetContractCreation(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no creation record for contract %s", address.Hex())
+	}
+
+	return &types.QueryContractCreationResponse{ContractCreation: creation}, nil
 }
 
 // buildTraceCtx builds a context for simulating or tracing transactions by:
@@ -900,3 +1112,154 @@ func buildTraceCtx(ctx sdk.Context, gasLimit uint64) sdk.Context {
 	return evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(types.NewInfiniteGasMeterWithLimit(gasLimit))
 }
+
+// Preinstalls implements the Query/Preinstalls gRPC method. It returns the
+// installed preinstalls together with their metadata.
+func (k Keeper) Preinstalls(c context.Context, req *types.QueryPreinstallsRequest) (*types.QueryPreinstallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var preinstalls []types.Preinstall
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPreinstall)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var preinstall types.Preinstall
+		if err := k.cdc.Unmarshal(value, &preinstall); err != nil {
+			return err
+		}
+		preinstalls = append(preinstalls, preinstall)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPreinstallsResponse{
+		Preinstalls: preinstalls,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// StorageRange implements the Query/StorageRange gRPC method. It returns a
+// page of the storage of a contract, in storage key order, at the beginning of
+// the requested block with the given predecessor transactions applied.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.MaxResult > maxStorageRangeResults {
+		return nil, status.Errorf(codes.InvalidArgument, "too many results requested, got %d: limit %d", req.MaxResult, maxStorageRangeResults)
+	}
+
+	if len(req.Predecessors) > maxTracePredecessors {
+		return nil, status.Errorf(codes.InvalidArgument, "too many predecessors, got %d: limit %d", len(req.Predecessors), maxTracePredecessors)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if len(req.Predecessors) > 0 {
+		var err error
+		ctx, _, _, _, err = k.replayPredecessors(
+			ctx, req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas, req.ProposerAddress, req.Predecessors,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var start []byte
+	if len(req.KeyStart) > 0 {
+		start = common.BytesToHash(req.KeyStart).Bytes()
+	}
+
+	addr := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	res := &types.QueryStorageRangeResponse{Storage: []types.State{}}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(res.Storage)) >= req.MaxResult {
+			res.NextKey = common.BytesToHash(iterator.Key()).Bytes()
+			break
+		}
+		res.Storage = append(res.Storage, types.NewState(
+			common.BytesToHash(iterator.Key()),
+			common.BytesToHash(iterator.Value()),
+		))
+	}
+
+	return res, nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method. It returns a page
+// of the EVM state of the accounts, in address order, starting from the given
+// address.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 || maxResults > maxAccountRangeResults {
+		maxResults = maxAccountRangeResults
+	}
+
+	authKey, ok := k.storeKeys[authtypes.StoreKey]
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth store not available")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryAccountRangeResponse{Accounts: []types.DumpAccount{}}
+
+	// the accounts are keyed by address, so the iteration starts at the
+	// start address rather than at the first account
+	var start []byte
+	if len(req.Start) > 0 {
+		start = req.Start
+	}
+	it := prefix.NewStore(ctx.KVStore(authKey), authtypes.AddressStoreKeyPrefix).Iterator(start, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		accAddr := it.Key()
+		if len(accAddr) != common.AddressLength {
+			continue
+		}
+		if uint64(len(res.Accounts)) >= maxResults {
+			res.Next = bytes.Clone(accAddr)
+			break
+		}
+
+		addr := common.BytesToAddress(accAddr)
+		acct := k.GetAccountOrEmpty(ctx, addr)
+		dump := types.DumpAccount{
+			Address:  addr.Hex(),
+			Balance:  acct.Balance.String(),
+			Nonce:    acct.Nonce,
+			CodeHash: common.BytesToHash(acct.CodeHash).Hex(),
+			Storage:  []types.State{},
+		}
+		if !req.NoCode && acct.HasCodeHash() {
+			dump.Code = k.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+		}
+		if !req.NoStorage {
+			k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+				dump.Storage = append(dump.Storage, types.NewState(key, value))
+				return true
+			})
+		}
+
+		res.Accounts = append(res.Accounts, dump)
+	}
+
+	return res, nil
+}
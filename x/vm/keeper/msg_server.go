@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -112,6 +113,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		),
 	})
 
+	// emit typed events for the logs of contracts with a registered ABI
+	if !response.Failed() {
+		k.EmitContractABIEvents(ctx, response.Logs)
+	}
+
 	return response, nil
 }
 
@@ -150,3 +156,104 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// RegisterContractABIs implements the gRPC MsgServer interface. When a
+// RegisterContractABIs proposal passes, it stores the contract ABIs used to
+// decode the logs of the contracts into typed events. The registration can
+// only be performed if the requested authority is the Cosmos SDK governance
+// module account.
+func (k *Keeper) RegisterContractABIs(goCtx context.Context, req *types.MsgRegisterContractABIs) (*types.MsgRegisterContractABIsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.AddContractABIs(ctx, req.ContractABIs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterContractABIsResponse{}, nil
+}
+
+// RemoveContractABIs implements the gRPC MsgServer interface. When a
+// RemoveContractABIs proposal passes, it removes the registered ABIs of the
+// given contracts. The removal can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) RemoveContractABIs(goCtx context.Context, req *types.MsgRemoveContractABIs) (*types.MsgRemoveContractABIsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, address := range req.Addresses {
+		addr := common.HexToAddress(address)
+		if _, found := k.GetContractABI(ctx, addr); !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidContractABI, "no abi registered for contract %s", addr.Hex())
+		}
+		k.DeleteContractABI(ctx, addr)
+	}
+
+	return &types.MsgRemoveContractABIsResponse{}, nil
+}
+
+// ScheduleEVMFork implements the gRPC MsgServer interface. When a
+// ScheduleEVMFork proposal passes, it schedules the activation time of an
+// Ethereum hard fork in the EVM chain config. The scheduling can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) ScheduleEVMFork(goCtx context.Context, req *types.MsgScheduleEVMFork) (*types.MsgScheduleEVMForkResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ScheduleFork(ctx, types.NewEVMForkSchedule(req.Fork, req.ActivationTime)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleEVMForkResponse{}, nil
+}
+
+// RegisterCodeMetadata implements the gRPC MsgServer interface. It stores the
+// deployment metadata of the code of a deployed contract after re-checking the
+// submitted runtime bytecode against the code stored for the contract, and the
+// submitted creation bytecode and constructor arguments against the recorded
+// creation of the contract. Registered metadata can only be replaced under
+// the rules of canReplaceCodeMetadata.
+func (k *Keeper) RegisterCodeMetadata(goCtx context.Context, req *types.MsgRegisterCodeMetadata) (*types.MsgRegisterCodeMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	address := common.HexToAddress(req.ContractAddress)
+	codeHash, creation, err := k.VerifyContractCode(ctx, address, req.RuntimeBytecode, req.CreationBytecode, req.ConstructorArguments)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, found := k.GetCodeMetadata(ctx, codeHash); found && !k.canReplaceCodeMetadata(existing, sender, creation) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidCodeMetadata,
+			"metadata of code %s already registered by %s", codeHash.Hex(), existing.Submitter,
+		)
+	}
+
+	metadata := req.CodeMetadata()
+	metadata.Height = ctx.BlockHeight()
+	metadata.CreationVerified = creation != nil
+	k.SetCodeMetadata(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCodeMetadata,
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, address.Hex()),
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender),
+		),
+	)
+
+	return &types.MsgRegisterCodeMetadataResponse{CodeHash: codeHash.Hex()}, nil
+}
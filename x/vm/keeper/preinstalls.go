@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,6 +28,10 @@ func (k *Keeper) AddPreinstalls(ctx sdk.Context, preinstalls []types.Preinstall)
 			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s has empty code hash", preinstall.Address)
 		}
 
+		if preinstall.CodeHash != "" && !bytes.Equal(common.HexToHash(preinstall.CodeHash).Bytes(), codeHash) {
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s code does not match the expected code hash %s", preinstall.Address, preinstall.CodeHash)
+		}
+
 		existingCodeHash := k.GetCodeHash(ctx, address)
 		if !types.IsEmptyCodeHash(existingCodeHash.Bytes()) && !bytes.Equal(existingCodeHash.Bytes(), codeHash) {
 			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s already has a code hash with a different code hash", preinstall.Address)
@@ -45,6 +50,46 @@ func (k *Keeper) AddPreinstalls(ctx sdk.Context, preinstalls []types.Preinstall)
 		k.SetCode(ctx, codeHash, common.FromHex(preinstall.Code))
 
 		// We are not setting any storage for preinstalls, so we skip that step.
+
+		k.SetPreinstall(ctx, preinstall.RegistryEntry())
 	}
 	return nil
 }
+
+// GetPreinstall returns the registry entry of the preinstalled contract at the
+// given address.
+func (k Keeper) GetPreinstall(ctx sdk.Context, address common.Address) (types.Preinstall, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PreinstallKey(address))
+	if len(bz) == 0 {
+		return types.Preinstall{}, false
+	}
+
+	var preinstall types.Preinstall
+	k.cdc.MustUnmarshal(bz, &preinstall)
+	return preinstall, true
+}
+
+// SetPreinstall stores the registry entry of a preinstalled contract.
+func (k Keeper) SetPreinstall(ctx sdk.Context, preinstall types.Preinstall) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&preinstall)
+	store.Set(types.PreinstallKey(common.HexToAddress(preinstall.Address)), bz)
+}
+
+// GetPreinstalls returns the registry entries of all the installed preinstalls.
+func (k Keeper) GetPreinstalls(ctx sdk.Context) []types.Preinstall {
+	preinstalls := []types.Preinstall{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixPreinstall)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var preinstall types.Preinstall
+		k.cdc.MustUnmarshal(iterator.Value(), &preinstall)
+		preinstalls = append(preinstalls, preinstall)
+	}
+
+	return preinstalls
+}
@@ -0,0 +1,520 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transferTopic is the topic of the ERC20 Transfer(address,address,uint256)
+// event, used for the synthetic logs of native coin transfers.
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// SimulateV1 implements the eth_simulateV1 rpc api. The calls of each block
+// are executed sequentially on top of the state of the requested block, each
+// call seeing the state changes of the previous ones. All the changes are
+// discarded once the simulation completes.
+//
+// The gas cap of the request is the gas budget of the whole simulation: the
+// gas limit of each call is capped to the gas left by the previous calls.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blocks, err := opts.SanitizeBlocks(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the simulated state changes are written to a cache that is never committed
+	ctx, _ = ctx.CacheContext()
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	budget := req.GasCap
+	if budget == 0 {
+		budget = math.MaxUint64
+	}
+
+	parentHash := k.GetHashFn(ctx)(uint64(ctx.BlockHeight())) //#nosec G115 -- int overflow is not a concern here
+	results := make([]rpctypes.SimBlockResult, 0, len(blocks))
+	for _, block := range blocks {
+		result, err := k.simulateBlock(ctx, cfg, req.GasCap, &budget, parentHash, block, opts)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+		parentHash = result.Hash
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Data: bz}, nil
+}
+
+// simulateBlock executes the calls of a single simulated block and returns its
+// result, consuming the gas used by the calls from the gas budget of the
+// simulation. The block overrides must have been sanitized beforehand.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	gasCap uint64,
+	budget *uint64,
+	parentHash common.Hash,
+	block rpctypes.SimBlock,
+	opts rpctypes.SimOpts,
+) (*rpctypes.SimBlockResult, error) {
+	overrides := block.BlockOverrides
+	number := overrides.Number.ToInt().Uint64()
+	timestamp := uint64(*overrides.Time)
+
+	ctx = ctx.
+		WithBlockHeight(int64(number)).                     //#nosec G115 -- int overflow is not a concern here
+		WithBlockTime(time.Unix(int64(timestamp), 0).UTC()) //#nosec G115 -- int overflow is not a concern here
+
+	blockCfg := *cfg
+	if overrides.FeeRecipient != nil {
+		blockCfg.CoinBase = *overrides.FeeRecipient
+	}
+	switch {
+	case overrides.BaseFeePerGas != nil:
+		blockCfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+	case !opts.Validation && cfg.BaseFee != nil:
+		// without validation, calls are allowed to use a zero gas price
+		blockCfg.BaseFee = new(big.Int)
+	}
+
+	gasLimit := antetypes.BlockGasLimit(ctx)
+	if overrides.GasLimit != nil {
+		gasLimit = uint64(*overrides.GasLimit)
+		ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(gasLimit))
+	}
+	if gasLimit == 0 {
+		// without block gas limit, the block is bounded by the gas cap
+		gasLimit = gasCap
+		if gasLimit == 0 {
+			gasLimit = math.MaxUint64
+		}
+	}
+
+	if block.StateOverrides != nil {
+		if err := k.applySimulatedStateOverrides(ctx, *block.StateOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var (
+		chainID = types.GetEthChainConfig().ChainID
+		gasUsed uint64
+		txs     = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders = make([]common.Address, 0, len(block.Calls))
+		calls   = make([]rpctypes.SimCallResult, 0, len(block.Calls))
+		logs    []*ethtypes.Log
+	)
+
+	for i, args := range block.Calls {
+		if err := ctx.Context().Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		from := args.GetFrom()
+		nonce := k.GetNonce(ctx, from)
+		if args.Nonce == nil {
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		} else if opts.Validation && uint64(*args.Nonce) != nonce {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid nonce of call %d in block %d: address %s, tx: %d state: %d", i, number, from, uint64(*args.Nonce), nonce)
+		}
+
+		if *budget == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d in block %d exceeds the gas cap of the simulation: %d", i, number, gasCap)
+		}
+
+		remaining := gasLimit - gasUsed
+		if args.Gas == nil {
+			args.Gas = (*hexutil.Uint64)(&remaining)
+		} else if uint64(*args.Gas) > remaining {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d in block %d exceeds the block gas limit: %d > %d", i, number, uint64(*args.Gas), remaining)
+		}
+
+		// the gas limit of the call is capped to the remaining gas budget
+		if err := args.CallDefaults(*budget, blockCfg.BaseFee, chainID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		msg := args.ToMessage(blockCfg.BaseFee, !opts.Validation, !opts.Validation)
+		if opts.Validation {
+			if err := k.validateSimulatedMessage(ctx, msg, blockCfg.BaseFee); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid call %d in block %d: %s", i, number, err)
+			}
+		}
+
+		txType := ethtypes.LegacyTxType
+		if blockCfg.BaseFee != nil {
+			txType = ethtypes.DynamicFeeTxType
+		}
+		tx := args.ToTransaction(txType)
+
+		var (
+			tracer    *tracing.Hooks
+			transfers *simTransferTracer
+		)
+		if opts.TraceTransfers {
+			transfers = newSimTransferTracer()
+			tracer = transfers.Hooks()
+		}
+
+		txConfig := statedb.NewTxConfig(tx.Hash(), uint(i), uint(len(logs))) //#nosec G115 -- int overflow is not a concern here
+		res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer, true, &blockCfg, txConfig, true, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to apply call %d in block %d: %s", i, number, err)
+		}
+
+		if err := k.finalizeSimulatedMessage(ctx, msg, res.GasUsed, opts.Validation); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		callLogs := types.LogsToEthereum(res.Logs)
+		if transfers != nil {
+			callLogs = transfers.Logs(callLogs)
+		}
+
+		call := rpctypes.SimCallResult{
+			ReturnValue: res.Ret,
+			Logs:        []*ethtypes.Log{},
+			GasUsed:     hexutil.Uint64(res.GasUsed),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if res.Failed() {
+			call.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			call.Error = newSimCallError(res)
+		} else {
+			for _, log := range callLogs {
+				log.TxHash = tx.Hash()
+				log.TxIndex = uint(i) //#nosec G115 -- int overflow is not a concern here
+				log.Index = uint(len(logs))
+				call.Logs = append(call.Logs, log)
+				logs = append(logs, log)
+			}
+		}
+
+		gasUsed += res.GasUsed
+		*budget -= res.GasUsed
+		txs = append(txs, tx)
+		senders = append(senders, from)
+		calls = append(calls, call)
+	}
+
+	var bloom ethtypes.Bloom
+	for _, log := range logs {
+		bloom.Add(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			bloom.Add(topic.Bytes())
+		}
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   blockCfg.CoinBase,
+		TxHash:     ethtypes.DeriveSha(ethtypes.Transactions(txs), trie.NewStackTrie(nil)),
+		Bloom:      bloom,
+		Difficulty: new(big.Int),
+		Number:     new(big.Int).SetUint64(number),
+		GasLimit:   gasLimit,
+		GasUsed:    gasUsed,
+		Time:       timestamp,
+		BaseFee:    blockCfg.BaseFee,
+	}
+	blockHash := header.Hash()
+
+	for _, log := range logs {
+		log.BlockHash = blockHash
+		log.BlockNumber = number
+		log.BlockTimestamp = timestamp
+	}
+
+	transactions := make([]interface{}, len(txs))
+	for i, tx := range txs {
+		if !opts.ReturnFullTransactions {
+			transactions[i] = tx.Hash()
+			continue
+		}
+		rpcTx := rpctypes.NewRPCTransaction(tx, blockHash, number, timestamp, uint64(i), blockCfg.BaseFee, types.GetEthChainConfig()) //#nosec G115 -- int overflow is not a concern here
+		rpcTx.From = senders[i]
+		transactions[i] = rpcTx
+	}
+
+	result := &rpctypes.SimBlockResult{
+		Number:       hexutil.Uint64(number),
+		Hash:         blockHash,
+		ParentHash:   parentHash,
+		Timestamp:    hexutil.Uint64(timestamp),
+		GasLimit:     hexutil.Uint64(gasLimit),
+		GasUsed:      hexutil.Uint64(gasUsed),
+		Miner:        blockCfg.CoinBase,
+		LogsBloom:    bloom,
+		Transactions: transactions,
+		Calls:        calls,
+	}
+	if blockCfg.BaseFee != nil {
+		result.BaseFeePerGas = (*hexutil.Big)(blockCfg.BaseFee)
+	}
+
+	return result, nil
+}
+
+// applySimulatedStateOverrides writes the state overrides of a simulated block
+// to the store, so that they are visible to all the calls of the block and of
+// the following blocks. StateOverride.Apply is not used since it installs the
+// overrides as in-memory base state that is never committed.
+func (k *Keeper) applySimulatedStateOverrides(ctx sdk.Context, overrides rpctypes.StateOverride) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+	for address, account := range overrides {
+		if account.MovePrecompileTo != nil {
+			return fmt.Errorf("account %s: moving precompiles is not supported in simulations", address.Hex())
+		}
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", address.Hex())
+		}
+
+		if account.Nonce != nil {
+			stateDB.SetNonce(address, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		if account.Code != nil {
+			stateDB.SetCode(address, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance, overflow := uint256.FromBig((*big.Int)(*account.Balance))
+			if overflow {
+				return fmt.Errorf("account %s: balance overflows 256 bits", address.Hex())
+			}
+			stateDB.SetBalance(address, balance, tracing.BalanceChangeUnspecified)
+		}
+	}
+	if err := stateDB.Commit(); err != nil {
+		return err
+	}
+
+	for address, account := range overrides {
+		if account.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, address, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.DeleteState(ctx, address, key)
+			}
+			k.setSimulatedStorage(ctx, address, *account.State)
+		}
+		if account.StateDiff != nil {
+			k.setSimulatedStorage(ctx, address, *account.StateDiff)
+		}
+	}
+
+	return nil
+}
+
+func (k *Keeper) setSimulatedStorage(ctx sdk.Context, address common.Address, storage map[common.Hash]common.Hash) {
+	for key, value := range storage {
+		if value == (common.Hash{}) {
+			k.DeleteState(ctx, address, key)
+		} else {
+			k.SetState(ctx, address, key, value.Bytes())
+		}
+	}
+}
+
+// validateSimulatedMessage performs the fee and balance checks of a simulated
+// message when validation is enabled.
+func (k *Keeper) validateSimulatedMessage(ctx sdk.Context, msg *core.Message, baseFee *big.Int) error {
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From, msg.GasFeeCap, baseFee)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	cost.Add(cost, msg.Value)
+
+	balance := k.GetAccountOrEmpty(ctx, msg.From).Balance
+	if balance.ToBig().Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From, balance, cost)
+	}
+
+	return nil
+}
+
+// finalizeSimulatedMessage increments the nonce of the sender of a call, since
+// it is otherwise incremented by the ante handler, and charges the fees of the
+// message when validation is enabled.
+func (k *Keeper) finalizeSimulatedMessage(ctx sdk.Context, msg *core.Message, gasUsed uint64, validation bool) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+
+	if msg.To != nil {
+		stateDB.SetNonce(msg.From, msg.Nonce+1, tracing.NonceChangeEoACall)
+	}
+
+	if validation {
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), msg.GasPrice)
+		stateDB.SubBalance(msg.From, uint256.MustFromBig(fee), tracing.BalanceDecreaseGasBuy)
+	}
+
+	return stateDB.Commit()
+}
+
+// newSimCallError returns the error of a failed simulated call.
+func newSimCallError(res *types.MsgEthereumTxResponse) *rpctypes.SimCallError {
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		return &rpctypes.SimCallError{
+			Code:    rpctypes.SimulateErrCodeVMError,
+			Message: res.VmError,
+		}
+	}
+
+	revertErr := types.NewExecErrorWithReason(res.Ret)
+	return &rpctypes.SimCallError{
+		Code:    rpctypes.SimulateErrCodeReverted,
+		Message: revertErr.Error(),
+		Data:    hexutil.Encode(res.Ret),
+	}
+}
+
+// simTransferTracer records the native coin transfers of a call as synthetic
+// ERC20 Transfer logs. The StateDB does not notify the tracer of the emitted
+// logs, so the LOG opcodes are tracked as placeholders to keep the transfer
+// logs ordered relatively to the logs of the call.
+type simTransferTracer struct {
+	// frames holds the logs of each call frame, nil entries are placeholders
+	// of the logs emitted by the LOG opcodes.
+	frames [][]*ethtypes.Log
+}
+
+func newSimTransferTracer() *simTransferTracer {
+	return &simTransferTracer{
+		frames: [][]*ethtypes.Log{{}},
+	}
+}
+
+// Hooks returns the tracing hooks of the tracer.
+func (t *simTransferTracer) Hooks() *tracing.Hooks {
+	hooks := types.NewNoOpTracer()
+	hooks.OnEnter = t.onEnter
+	hooks.OnExit = t.onExit
+	hooks.OnOpcode = t.onOpcode
+	return hooks
+}
+
+func (t *simTransferTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, []*ethtypes.Log{})
+
+	if value == nil || value.Sign() <= 0 || vm.OpCode(typ) == vm.DELEGATECALL {
+		return
+	}
+
+	t.append(&ethtypes.Log{
+		Address: rpctypes.SimulateTransferAddress,
+		Topics: []common.Hash{
+			transferTopic,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.BigToHash(value).Bytes(),
+	})
+}
+
+func (t *simTransferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	last := len(t.frames) - 1
+	frame := t.frames[last]
+	t.frames = t.frames[:last]
+
+	// the logs of reverted frames are discarded
+	if !reverted {
+		t.frames[last-1] = append(t.frames[last-1], frame...)
+	}
+}
+
+func (t *simTransferTracer) onOpcode(_ uint64, op byte, _, _ uint64, _ tracing.OpContext, _ []byte, _ int, err error) {
+	if err != nil {
+		return
+	}
+	switch vm.OpCode(op) {
+	case vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4:
+		t.append(nil)
+	}
+}
+
+func (t *simTransferTracer) append(log *ethtypes.Log) {
+	last := len(t.frames) - 1
+	t.frames[last] = append(t.frames[last], log)
+}
+
+// Logs merges the logs of the call with the recorded transfer logs. If the
+// number of logs does not match the tracked LOG opcodes (e.g. because of logs
+// emitted by precompiles), the transfer logs are appended after the logs of
+// the call.
+func (t *simTransferTracer) Logs(callLogs []*ethtypes.Log) []*ethtypes.Log {
+	recorded := t.frames[0]
+
+	var transfers []*ethtypes.Log
+	placeholders := 0
+	for _, log := range recorded {
+		if log == nil {
+			placeholders++
+		} else {
+			transfers = append(transfers, log)
+		}
+	}
+
+	if placeholders != len(callLogs) {
+		return append(callLogs, transfers...)
+	}
+
+	logs := make([]*ethtypes.Log, 0, len(recorded))
+	next := 0
+	for _, log := range recorded {
+		if log == nil {
+			log = callLogs[next]
+			next++
+		}
+		logs = append(logs, log)
+	}
+	return logs
+}
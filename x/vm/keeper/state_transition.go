@@ -213,8 +213,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commitFn := ctx.CacheContext()
 
+	// record the contracts created by the transaction, including the ones
+	// created through CREATE and CREATE2 operations of nested calls
+	creationRecorder := newContractCreationRecorder()
+	tracer := creationRecorder.Hooks(k.Tracer(ctx, *msg, types.GetEthChainConfig()))
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false, nil)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, tracer, true, cfg, txConfig, false, nil)
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -302,6 +307,11 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		}
 	}
 
+	// the state of the transaction is only committed when it succeeded
+	if !res.Failed() {
+		k.recordContractCreations(ctx, creationRecorder, txConfig.TxHash)
+	}
+
 	// update logs and bloom for full view if post processing updated them
 	ethLogs = types.LogsToEthereum(res.Logs)
 	bloom, _ := k.initializeBloomFromLogs(ctx, ethLogs)
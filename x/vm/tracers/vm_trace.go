@@ -0,0 +1,290 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// VMTracerName is the name under which the Parity style vmTrace tracer is
+// registered in the geth tracers directory.
+const VMTracerName = "parityVmTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(VMTracerName, newVMTracer, false)
+}
+
+// VMTrace is the Parity style trace of the execution of a single call frame.
+type VMTrace struct {
+	Code hexutil.Bytes  `json:"code"`
+	Ops  []*VMOperation `json:"ops"`
+}
+
+// VMOperation is a single executed instruction of a VMTrace. Sub holds the
+// trace of the call frame created by the instruction, if any.
+type VMOperation struct {
+	Cost uint64               `json:"cost"`
+	Ex   *VMExecutedOperation `json:"ex"`
+	PC   uint64               `json:"pc"`
+	Sub  *VMTrace             `json:"sub"`
+}
+
+// VMExecutedOperation holds the effects of an executed instruction. It is
+// nil if the instruction failed.
+type VMExecutedOperation struct {
+	Mem   *MemoryDiff  `json:"mem"`
+	Push  []string     `json:"push"`
+	Store *StorageDiff `json:"store"`
+	Used  uint64       `json:"used"`
+}
+
+// MemoryDiff is the memory region written by an instruction.
+type MemoryDiff struct {
+	Off  uint64        `json:"off"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StorageDiff is the storage slot written by an instruction.
+type StorageDiff struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// pendingOp is an instruction whose effects are only known once the next
+// instruction of the same call frame is reached.
+type pendingOp struct {
+	op              *VMOperation
+	opcode          vm.OpCode
+	gas             uint64
+	memOff, memSize uint64
+	store           *StorageDiff
+	failed          bool
+}
+
+type vmFrame struct {
+	trace   *VMTrace
+	pending *pendingOp
+}
+
+// vmTracer builds a Parity style vmTrace of a transaction.
+type vmTracer struct {
+	root      *VMTrace
+	frames    []*vmFrame
+	interrupt atomic.Bool
+	reason    error
+}
+
+func newVMTracer(_ *tracers.Context, _ json.RawMessage, _ *params.ChainConfig) (*tracers.Tracer, error) {
+	t := &vmTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+			OnOpcode:  t.OnOpcode,
+			OnFault:   t.OnFault,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+// OnTxStart resets the tracer state.
+func (t *vmTracer) OnTxStart(_ *tracing.VMContext, _ *types.Transaction, _ common.Address) {
+	t.root = nil
+	t.frames = nil
+}
+
+// OnEnter opens a new call frame. Nested frames are attached to the
+// instruction of the parent frame that created them.
+func (t *vmTracer) OnEnter(depth int, typ byte, _ common.Address, _ common.Address, input []byte, _ uint64, _ *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+	trace := &VMTrace{Code: hexutil.Bytes{}, Ops: []*VMOperation{}}
+	if op := vm.OpCode(typ); op == vm.CREATE || op == vm.CREATE2 {
+		trace.Code = common.CopyBytes(input)
+	}
+
+	if depth == 0 || len(t.frames) == 0 {
+		t.root = trace
+	} else if parent := t.frames[len(t.frames)-1]; parent.pending != nil {
+		parent.pending.op.Sub = trace
+	}
+	t.frames = append(t.frames, &vmFrame{trace: trace})
+}
+
+// OnExit closes the current call frame.
+func (t *vmTracer) OnExit(_ int, _ []byte, _ uint64, _ error, _ bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if p := frame.pending; p != nil {
+		t.finalize(p, nil, p.gas-min(p.gas, p.op.Cost))
+		frame.pending = nil
+	}
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+// OnOpcode completes the previous instruction of the current frame from the
+// state observed before executing the next one, and records the new one.
+func (t *vmTracer) OnOpcode(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, _ []byte, _ int, _ error) {
+	if t.interrupt.Load() || len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if len(frame.trace.Ops) == 0 && len(frame.trace.Code) == 0 {
+		frame.trace.Code = common.CopyBytes(scope.ContractCode())
+	}
+	if frame.pending != nil {
+		t.finalize(frame.pending, scope, gas)
+	}
+
+	p := &pendingOp{
+		op:     &VMOperation{Cost: cost, PC: pc},
+		opcode: vm.OpCode(op),
+		gas:    gas,
+	}
+	stack := scope.StackData()
+	switch p.opcode {
+	case vm.SSTORE:
+		if len(stack) >= 2 {
+			p.store = &StorageDiff{
+				Key: stackBack(stack, 0).Hex(),
+				Val: stackBack(stack, 1).Hex(),
+			}
+		}
+	default:
+		p.memOff, p.memSize = memoryWrite(p.opcode, stack)
+	}
+
+	frame.trace.Ops = append(frame.trace.Ops, p.op)
+	frame.pending = p
+}
+
+// OnFault marks the current instruction as failed.
+func (t *vmTracer) OnFault(_ uint64, _ byte, _, _ uint64, _ tracing.OpContext, _ int, _ error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	if p := t.frames[len(t.frames)-1].pending; p != nil {
+		p.failed = true
+	}
+}
+
+// GetResult returns the vmTrace of the transaction.
+func (t *vmTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		t.root = &VMTrace{Code: hexutil.Bytes{}, Ops: []*VMOperation{}}
+	}
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates the execution of the tracer at the first opportune moment.
+func (t *vmTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// finalize fills in the effects of the pending instruction. scope is the
+// context of the next instruction of the same frame, or nil if the frame
+// ended.
+func (t *vmTracer) finalize(p *pendingOp, scope tracing.OpContext, used uint64) {
+	if p.failed {
+		return
+	}
+	ex := &VMExecutedOperation{Push: []string{}, Store: p.store, Used: used}
+	if scope != nil {
+		stack := scope.StackData()
+		n := stackPushes(p.opcode)
+		for i := min(n, len(stack)); i > 0; i-- {
+			ex.Push = append(ex.Push, stackBack(stack, i-1).Hex())
+		}
+		if p.memSize > 0 {
+			mem := scope.MemoryData()
+			if end := p.memOff + p.memSize; end >= p.memOff && end <= uint64(len(mem)) {
+				ex.Mem = &MemoryDiff{Off: p.memOff, Data: common.CopyBytes(mem[p.memOff:end])}
+			}
+		}
+	}
+	p.op.Ex = ex
+}
+
+// stackBack returns the n-th item from the top of the stack.
+func stackBack(stack []uint256.Int, n int) *uint256.Int {
+	return &stack[len(stack)-n-1]
+}
+
+// memoryWrite returns the memory region written by the given instruction,
+// computed from the stack before its execution.
+func memoryWrite(op vm.OpCode, stack []uint256.Int) (offset, size uint64) {
+	region := func(offPos, sizePos int) (uint64, uint64) {
+		if len(stack) <= max(offPos, sizePos) {
+			return 0, 0
+		}
+		off, size := stackBack(stack, offPos), stackBack(stack, sizePos)
+		if !off.IsUint64() || !size.IsUint64() {
+			return 0, 0
+		}
+		return off.Uint64(), size.Uint64()
+	}
+
+	switch op {
+	case vm.MSTORE, vm.MSTORE8:
+		if len(stack) == 0 || !stackBack(stack, 0).IsUint64() {
+			return 0, 0
+		}
+		if op == vm.MSTORE8 {
+			return stackBack(stack, 0).Uint64(), 1
+		}
+		return stackBack(stack, 0).Uint64(), 32
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		return region(0, 2)
+	case vm.EXTCODECOPY:
+		return region(1, 3)
+	case vm.CALL, vm.CALLCODE:
+		return region(5, 6)
+	case vm.DELEGATECALL, vm.STATICCALL:
+		return region(4, 5)
+	}
+	return 0, 0
+}
+
+// stackPushes returns the number of stack items pushed by the given
+// instruction, as reported in the Parity vmTrace.
+func stackPushes(op vm.OpCode) int {
+	switch {
+	case op >= vm.PUSH0 && op <= vm.PUSH32:
+		return 1
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return 0
+	}
+
+	switch op {
+	case vm.STOP, vm.POP, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+		vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE,
+		vm.CALLDATACOPY, vm.CODECOPY, vm.EXTCODECOPY, vm.RETURNDATACOPY, vm.MCOPY,
+		vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID:
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,53 @@
+package tracers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/tracers"
+)
+
+func TestVMTracer(t *testing.T) {
+	// PUSH1 0x2a PUSH1 0x00 MSTORE PUSH1 0x01 PUSH1 0x00 SSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	code := common.FromHex("602a600052600160005560206000f3")
+
+	tracer, err := gethtracers.DefaultDirectory.New(tracers.VMTracerName, &gethtracers.Context{}, json.RawMessage(`{}`), params.MergedTestChainConfig)
+	require.NoError(t, err)
+
+	ret, _, err := runtime.Execute(code, nil, &runtime.Config{
+		ChainConfig: params.MergedTestChainConfig,
+		GasLimit:    100000,
+		EVMConfig:   vm.Config{Tracer: tracer.Hooks},
+	})
+	require.NoError(t, err)
+	require.Len(t, ret, 32)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var trace tracers.VMTrace
+	require.NoError(t, json.Unmarshal(res, &trace))
+	require.Equal(t, code, []byte(trace.Code))
+	require.Len(t, trace.Ops, 9)
+
+	for i, op := range trace.Ops {
+		require.NotNil(t, op.Ex, "op %d", i)
+		require.Nil(t, op.Sub)
+		if i > 0 {
+			require.Equal(t, trace.Ops[i-1].Ex.Used-op.Cost, op.Ex.Used)
+		}
+	}
+
+	require.Equal(t, []string{"0x2a"}, trace.Ops[0].Ex.Push)
+	require.Empty(t, trace.Ops[2].Ex.Push)
+	require.Equal(t, &tracers.MemoryDiff{Off: 0, Data: common.LeftPadBytes([]byte{0x2a}, 32)}, trace.Ops[2].Ex.Mem)
+	require.Equal(t, &tracers.StorageDiff{Key: "0x0", Val: "0x1"}, trace.Ops[5].Ex.Store)
+	require.Equal(t, uint64(14), trace.Ops[8].PC)
+}
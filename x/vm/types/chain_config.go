@@ -2,6 +2,7 @@ package types
 
 import (
 	"math/big"
+	"sync/atomic"
 
 	gethparams "github.com/ethereum/go-ethereum/params"
 
@@ -10,8 +11,14 @@ import (
 )
 
 // chainConfig is the chain configuration used in the EVM to defined which
-// opcodes are active based on Ethereum upgrades.
-var chainConfig *ChainConfig
+// opcodes are active based on Ethereum upgrades. It is replaced in BeginBlock
+// when scheduled forks are applied while the RPC servers read it, hence stored
+// atomically.
+var chainConfig atomic.Pointer[ChainConfig]
+
+// baseChainConfig is the chain configuration set by the EVMConfigurator,
+// before the activation times of the forks scheduled through governance.
+var baseChainConfig *ChainConfig
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
 // All the negative or nil values are converted to nil
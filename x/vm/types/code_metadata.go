@@ -0,0 +1,88 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/utils"
+)
+
+// maxCompilerVersionLength is the maximum length of the compiler version of a
+// registered code.
+const maxCompilerVersionLength = 128
+
+// Validate performs a stateless validation of the code metadata fields.
+func (m CodeMetadata) Validate() error {
+	if err := validateHexHash(m.CodeHash); err != nil {
+		return fmt.Errorf("invalid code hash: %w", err)
+	}
+	if IsEmptyCodeHash(common.HexToHash(m.CodeHash).Bytes()) {
+		return fmt.Errorf("code hash %s corresponds to empty code", m.CodeHash)
+	}
+
+	if len(m.CompilerVersion) > maxCompilerVersionLength {
+		return fmt.Errorf("compiler version length %d exceeds the maximum of %d", len(m.CompilerVersion), maxCompilerVersionLength)
+	}
+
+	if m.Abi != "" {
+		if _, err := abi.JSON(strings.NewReader(m.Abi)); err != nil {
+			return fmt.Errorf("invalid abi: %w", err)
+		}
+	}
+
+	if m.SourceHash != "" {
+		if err := validateHexHash(m.SourceHash); err != nil {
+			return fmt.Errorf("invalid source hash: %w", err)
+		}
+	}
+
+	if m.ContractAddress != "" {
+		if err := utils.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+			return fmt.Errorf("invalid contract address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCode checks that the metadata matches the given runtime bytecode:
+// the selector of every function of the ABI must be pushed by the code, as
+// done by the function dispatcher of the Solidity and Vyper compilers. The
+// leading zero bytes of the selectors are ignored, as the compilers may push
+// them as shorter values.
+func (m CodeMetadata) ValidateCode(runtimeBytecode []byte) error {
+	if m.Abi == "" {
+		return nil
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(m.Abi))
+	if err != nil {
+		return fmt.Errorf("invalid abi: %w", err)
+	}
+
+	for _, method := range contractABI.Methods {
+		if !bytes.Contains(runtimeBytecode, bytes.TrimLeft(method.ID, "\x00")) {
+			return fmt.Errorf("function %s of the abi is not dispatched by the code", method.Sig)
+		}
+	}
+
+	return nil
+}
+
+// validateHexHash returns an error if the provided string is not a 0x prefixed
+// hex encoded 32 bytes hash.
+func validateHexHash(hash string) error {
+	bz, err := hexutil.Decode(hash)
+	if err != nil {
+		return err
+	}
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("expected %d bytes, got %d", common.HashLength, len(bz))
+	}
+	return nil
+}
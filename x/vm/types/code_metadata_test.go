@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeMetadata_Validate(t *testing.T) {
+	codeHash := crypto.Keccak256Hash([]byte{0x60, 0x80}).Hex()
+
+	testCases := []struct {
+		name     string
+		metadata CodeMetadata
+		errorMsg string
+	}{
+		{
+			name:     "valid metadata with code hash only",
+			metadata: CodeMetadata{CodeHash: codeHash},
+		},
+		{
+			name: "valid full metadata",
+			metadata: CodeMetadata{
+				CodeHash:        codeHash,
+				CompilerVersion: "0.8.28+commit.7893614a",
+				Abi:             testTransferABI,
+				SourceHash:      crypto.Keccak256Hash([]byte("source")).Hex(),
+				ContractAddress: "0x1234567890123456789012345678901234567890",
+			},
+		},
+		{
+			name:     "invalid code hash",
+			metadata: CodeMetadata{CodeHash: "0x1234"},
+			errorMsg: "invalid code hash",
+		},
+		{
+			name:     "empty code hash",
+			metadata: CodeMetadata{CodeHash: crypto.Keccak256Hash(nil).Hex()},
+			errorMsg: "empty code",
+		},
+		{
+			name:     "invalid abi",
+			metadata: CodeMetadata{CodeHash: codeHash, Abi: "{"},
+			errorMsg: "invalid abi",
+		},
+		{
+			name:     "invalid contract address",
+			metadata: CodeMetadata{CodeHash: codeHash, ContractAddress: "0x12"},
+			errorMsg: "invalid contract address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestMsgRegisterCodeMetadata_CodeMetadata(t *testing.T) {
+	bytecode := []byte{0x60, 0x80, 0x60, 0x40}
+	msg := MsgRegisterCodeMetadata{
+		Sender:          "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+		ContractAddress: "0x1234567890123456789012345678901234567890",
+		RuntimeBytecode: bytecode,
+		CompilerVersion: "0.8.28",
+	}
+
+	metadata := msg.CodeMetadata()
+	require.Equal(t, crypto.Keccak256Hash(bytecode).Hex(), metadata.CodeHash)
+	require.Equal(t, msg.Sender, metadata.Submitter)
+	require.Equal(t, msg.CompilerVersion, metadata.CompilerVersion)
+}
+
+func TestCodeMetadata_ValidateCode(t *testing.T) {
+	// the selector of transfer(address,uint256) is 0xa9059cbb, the one of
+	// f490() is 0x00a965e5
+	transferABI := `[{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+	zeroSelectorABI := `[{"inputs":[],"name":"f490","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+	// PUSH4 0xa9059cbb EQ
+	transferCode := []byte{0x63, 0xa9, 0x05, 0x9c, 0xbb, 0x14}
+	// PUSH3 0xa965e5 EQ
+	zeroSelectorCode := []byte{0x62, 0xa9, 0x65, 0xe5, 0x14}
+
+	testCases := []struct {
+		name     string
+		abi      string
+		code     []byte
+		errorMsg string
+	}{
+		{
+			name: "no abi",
+			code: transferCode,
+		},
+		{
+			name: "dispatched function",
+			abi:  transferABI,
+			code: transferCode,
+		},
+		{
+			name: "dispatched function with a leading zero selector byte",
+			abi:  zeroSelectorABI,
+			code: zeroSelectorCode,
+		},
+		{
+			name:     "function not dispatched",
+			abi:      transferABI,
+			code:     zeroSelectorCode,
+			errorMsg: "function transfer(address,uint256) of the abi is not dispatched",
+		},
+		{
+			name: "events only abi",
+			abi:  testTransferABI,
+			code: []byte{0x60, 0x80},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CodeMetadata{Abi: tc.abi}.ValidateCode(tc.code)
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
@@ -39,6 +39,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgRegisterContractABIs{},
+		&MsgRemoveContractABIs{},
+		&MsgScheduleEVMFork{},
+		&MsgRegisterCodeMetadata{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
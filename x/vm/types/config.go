@@ -48,19 +48,19 @@ func (ec *EVMConfigurator) ResetTestConfig() {
 
 // GetEthChainConfig returns the `chainConfig` used in the EVM (geth type).
 func GetEthChainConfig() *geth.ChainConfig {
-	return chainConfig.EthereumConfig(nil)
+	return chainConfig.Load().EthereumConfig(nil)
 }
 
 // GetChainConfig returns the `chainConfig`.
 func GetChainConfig() *ChainConfig {
-	return chainConfig
+	return chainConfig.Load()
 }
 
 // SetChainConfig allows to set the `chainConfig` variable modifying the
 // default values. The method is private because it should only be called once
 // in the EVMConfigurator.
 func SetChainConfig(cc *ChainConfig) error {
-	if chainConfig != nil && chainConfig.ChainId != DefaultEVMChainID {
+	if current := chainConfig.Load(); current != nil && current.ChainId != DefaultEVMChainID {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig")
 	}
 	config := DefaultChainConfig(0)
@@ -70,7 +70,20 @@ func SetChainConfig(cc *ChainConfig) error {
 	if err := config.Validate(); err != nil {
 		return err
 	}
-	chainConfig = config
+	baseChainConfig = config
+	chainConfig.Store(config)
 
 	return nil
 }
+
+// getBaseChainConfig returns the `chainConfig` as set, without the scheduled
+// forks.
+func getBaseChainConfig() *ChainConfig {
+	return baseChainConfig
+}
+
+// replaceChainConfig replaces the `chainConfig` variable with an updated copy.
+// It is used to apply the hard forks scheduled through governance.
+func replaceChainConfig(cc *ChainConfig) {
+	chainConfig.Store(cc)
+}
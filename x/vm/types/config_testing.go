@@ -10,13 +10,19 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
+
 	"github.com/ethereum/go-ethereum/core/vm"
 	geth "github.com/ethereum/go-ethereum/params"
 )
 
 // testChainConfig is the chain configuration used in the EVM to defined which
 // opcodes are active based on Ethereum upgrades.
-var testChainConfig *ChainConfig
+var testChainConfig atomic.Pointer[ChainConfig]
+
+// testBaseChainConfig is the chain configuration as set, before the activation
+// times of the scheduled forks.
+var testBaseChainConfig *ChainConfig
 
 // Configure applies the changes to the virtual machine configuration.
 func (ec *EVMConfigurator) Configure() error {
@@ -48,11 +54,12 @@ func (ec *EVMConfigurator) Configure() error {
 func (ec *EVMConfigurator) ResetTestConfig() {
 	vm.ResetActivators()
 	resetEVMCoinInfo()
-	testChainConfig = nil
+	testChainConfig.Store(nil)
+	testBaseChainConfig = nil
 }
 
 func setTestChainConfig(cc *ChainConfig) error {
-	if testChainConfig != nil {
+	if testChainConfig.Load() != nil {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig. Call the configurators ResetTestConfig method before configuring a new chain.")
 	}
 	config := DefaultChainConfig(0)
@@ -62,7 +69,8 @@ func setTestChainConfig(cc *ChainConfig) error {
 	if err := config.Validate(); err != nil {
 		return err
 	}
-	testChainConfig = config
+	testBaseChainConfig = config
+	testChainConfig.Store(config)
 	return nil
 }
 
@@ -70,7 +78,7 @@ func setTestChainConfig(cc *ChainConfig) error {
 // default values. The method is private because it should only be called once
 // in the EVMConfigurator.
 func SetChainConfig(cc *ChainConfig) error {
-	if chainConfig != nil && chainConfig.ChainId != DefaultEVMChainID {
+	if current := chainConfig.Load(); current != nil && current.ChainId != DefaultEVMChainID {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig")
 	}
 	config := DefaultChainConfig(0)
@@ -80,17 +88,30 @@ func SetChainConfig(cc *ChainConfig) error {
 	if err := config.Validate(); err != nil {
 		return err
 	}
-	testChainConfig = config
+	testBaseChainConfig = config
+	testChainConfig.Store(config)
 
 	return nil
 }
 
 // GetEthChainConfig returns the `chainConfig` used in the EVM (geth type).
 func GetEthChainConfig() *geth.ChainConfig {
-	return testChainConfig.EthereumConfig(nil)
+	return testChainConfig.Load().EthereumConfig(nil)
 }
 
 // GetChainConfig returns the `chainConfig`.
 func GetChainConfig() *ChainConfig {
-	return testChainConfig
+	return testChainConfig.Load()
+}
+
+// getBaseChainConfig returns the `testChainConfig` as set, without the
+// scheduled forks.
+func getBaseChainConfig() *ChainConfig {
+	return testBaseChainConfig
+}
+
+// replaceChainConfig replaces the `testChainConfig` variable with an updated
+// copy. It is used to apply the hard forks scheduled through governance.
+func replaceChainConfig(cc *ChainConfig) {
+	testChainConfig.Store(cc)
 }
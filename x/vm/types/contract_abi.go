@@ -0,0 +1,195 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractABIEventPrefix is the first segment of the type of the typed Cosmos
+// events emitted for logs of registered contracts, i.e. evm.<name>.<EventName>.
+const ContractABIEventPrefix = ModuleName
+
+// AttributeKeyLogIndex is the attribute key holding the block log index of the
+// decoded log.
+const AttributeKeyLogIndex = "logIndex"
+
+// ContractABIArgumentPrefix prefixes the attribute keys of the decoded event
+// arguments, so that they never collide with the reserved keys of the event.
+const ContractABIArgumentPrefix = "arg."
+
+// contractABINameRegex restricts contract names to characters that are safe to
+// use within a CometBFT event query.
+var contractABINameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+
+// NewContractABI creates a new ContractABI instance.
+func NewContractABI(address common.Address, name, abiJSON string) ContractABI {
+	return ContractABI{
+		Address: address.Hex(),
+		Name:    name,
+		Abi:     abiJSON,
+	}
+}
+
+// Validate performs a stateless validation of the contract ABI fields.
+func (c ContractABI) Validate() error {
+	if err := utils.ValidateNonZeroAddress(c.Address); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	if !contractABINameRegex.MatchString(c.Name) {
+		return fmt.Errorf("invalid contract name %q, must match %s", c.Name, contractABINameRegex)
+	}
+
+	parsed, err := c.ParseABI()
+	if err != nil {
+		return fmt.Errorf("invalid abi for contract %s: %w", c.Address, err)
+	}
+
+	if len(parsed.Events) == 0 {
+		return fmt.Errorf("abi for contract %s does not define any event", c.Address)
+	}
+
+	// the decoded arguments are keyed by name, the unnamed ones being named
+	// arg<position> by the ABI parser
+	for _, event := range parsed.Events {
+		names := make(map[string]bool, len(event.Inputs))
+		for _, input := range event.Inputs {
+			if names[input.Name] {
+				return fmt.Errorf("event %s of contract %s has duplicate argument %s", event.Name, c.Address, input.Name)
+			}
+			names[input.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// ParseABI parses the JSON encoded ABI of the contract.
+func (c ContractABI) ParseABI() (abi.ABI, error) {
+	return abi.JSON(strings.NewReader(c.Abi))
+}
+
+// EventType returns the type of the Cosmos event emitted for the given ABI
+// event of the contract.
+func (c ContractABI) EventType(eventName string) string {
+	return fmt.Sprintf("%s.%s.%s", ContractABIEventPrefix, c.Name, eventName)
+}
+
+// DecodeLog decodes the given log with the parsed contract ABI and returns the
+// corresponding typed Cosmos event. The returned boolean is false when the log
+// does not match any non-anonymous event of the ABI.
+func (c ContractABI) DecodeLog(parsed abi.ABI, log *Log) (sdk.Event, bool, error) {
+	if len(log.Topics) == 0 {
+		return sdk.Event{}, false, nil
+	}
+
+	event, err := parsed.EventByID(common.HexToHash(log.Topics[0]))
+	if err != nil || event.Anonymous {
+		return sdk.Event{}, false, nil
+	}
+
+	// name unnamed arguments after their position so that every argument
+	// results in a distinct attribute key
+	inputs := make(abi.Arguments, len(event.Inputs))
+	copy(inputs, event.Inputs)
+	for i := range inputs {
+		if inputs[i].Name == "" {
+			inputs[i].Name = fmt.Sprintf("arg%d", i)
+		}
+	}
+
+	values := make(map[string]interface{}, len(inputs))
+
+	var indexed abi.Arguments
+	for _, input := range inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+
+	topics := make([]common.Hash, 0, len(log.Topics)-1)
+	for _, topic := range log.Topics[1:] {
+		topics = append(topics, common.HexToHash(topic))
+	}
+
+	if err := abi.ParseTopicsIntoMap(values, indexed, topics); err != nil {
+		return sdk.Event{}, false, fmt.Errorf("failed to parse topics of event %s: %w", event.Name, err)
+	}
+
+	nonIndexed := inputs.NonIndexed()
+	unpacked, err := nonIndexed.Unpack(log.Data)
+	if err != nil {
+		return sdk.Event{}, false, fmt.Errorf("failed to unpack data of event %s: %w", event.Name, err)
+	}
+	for i, input := range nonIndexed {
+		values[input.Name] = unpacked[i]
+	}
+
+	attrs := make([]sdk.Attribute, 0, len(inputs)+3)
+	for _, input := range inputs {
+		attrs = append(attrs, sdk.NewAttribute(ContractABIArgumentPrefix+input.Name, FormatABIValue(values[input.Name])))
+	}
+	attrs = append(attrs,
+		sdk.NewAttribute(AttributeKeyContractAddress, common.HexToAddress(log.Address).Hex()),
+		sdk.NewAttribute(AttributeKeyEthereumTxHash, log.TxHash),
+		sdk.NewAttribute(AttributeKeyLogIndex, strconv.FormatUint(log.Index, 10)),
+	)
+
+	return sdk.NewEvent(c.EventType(event.Name), attrs...), true, nil
+}
+
+// FormatABIValue returns the string representation of a decoded ABI value used
+// as a Cosmos event attribute value. Addresses and byte values are hex encoded,
+// integers are formatted in base 10 and composite values are JSON encoded.
+func FormatABIValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Array:
+		// fixed size byte arrays (bytes1 to bytes32)
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bz), rv)
+			return hexutil.Encode(bz)
+		}
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bz)
+}
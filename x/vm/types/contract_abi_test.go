@@ -0,0 +1,179 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testTransferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func TestContractABI_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		contractABI ContractABI
+		errorMsg    string
+	}{
+		{
+			name:        "valid contract abi",
+			contractABI: NewContractABI(common.HexToAddress("0x1234567890123456789012345678901234567890"), "Token", testTransferABI),
+		},
+		{
+			name: "zero address",
+			contractABI: ContractABI{
+				Address: common.Address{}.Hex(),
+				Name:    "Token",
+				Abi:     testTransferABI,
+			},
+			errorMsg: "invalid contract address",
+		},
+		{
+			name: "invalid name",
+			contractABI: ContractABI{
+				Address: "0x1234567890123456789012345678901234567890",
+				Name:    "my.token",
+				Abi:     testTransferABI,
+			},
+			errorMsg: "invalid contract name",
+		},
+		{
+			name: "invalid abi",
+			contractABI: ContractABI{
+				Address: "0x1234567890123456789012345678901234567890",
+				Name:    "Token",
+				Abi:     "not json",
+			},
+			errorMsg: "invalid abi",
+		},
+		{
+			name: "abi without events",
+			contractABI: ContractABI{
+				Address: "0x1234567890123456789012345678901234567890",
+				Name:    "Token",
+				Abi:     `[{"inputs":[],"name":"foo","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+			},
+			errorMsg: "does not define any event",
+		},
+		{
+			name: "duplicate event argument",
+			contractABI: ContractABI{
+				Address: "0x1234567890123456789012345678901234567890",
+				Name:    "Token",
+				Abi:     `[{"anonymous":false,"inputs":[{"indexed":false,"name":"value","type":"uint256"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Twice","type":"event"}]`,
+			},
+			errorMsg: "duplicate argument value",
+		},
+		{
+			name: "unnamed event argument named like another one",
+			contractABI: ContractABI{
+				Address: "0x1234567890123456789012345678901234567890",
+				Name:    "Token",
+				Abi:     `[{"anonymous":false,"inputs":[{"indexed":false,"name":"arg1","type":"uint256"},{"indexed":false,"name":"","type":"uint256"}],"name":"Collide","type":"event"}]`,
+			},
+			errorMsg: "duplicate argument arg1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.contractABI.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestContractABI_DecodeLog(t *testing.T) {
+	contract := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	from := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	contractABI := NewContractABI(contract, "Token", testTransferABI)
+	parsed, err := contractABI.ParseABI()
+	require.NoError(t, err)
+
+	log := &Log{
+		Address: contract.Hex(),
+		Topics: []string{
+			parsed.Events["Transfer"].ID.Hex(),
+			common.BytesToHash(from.Bytes()).Hex(),
+			common.BytesToHash(to.Bytes()).Hex(),
+		},
+		Data:   common.LeftPadBytes(big.NewInt(1000).Bytes(), 32),
+		TxHash: common.HexToHash("0x01").Hex(),
+		Index:  3,
+	}
+
+	event, ok, err := contractABI.DecodeLog(parsed, log)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "evm.Token.Transfer", event.Type)
+
+	attrs := make(map[string]string)
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, from.Hex(), attrs[ContractABIArgumentPrefix+"from"])
+	require.Equal(t, to.Hex(), attrs[ContractABIArgumentPrefix+"to"])
+	require.Equal(t, "1000", attrs[ContractABIArgumentPrefix+"value"])
+	require.Equal(t, contract.Hex(), attrs[AttributeKeyContractAddress])
+	require.Equal(t, "3", attrs[AttributeKeyLogIndex])
+
+	// unknown event signature
+	log.Topics[0] = common.HexToHash("0x02").Hex()
+	_, ok, err = contractABI.DecodeLog(parsed, log)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestContractABI_DecodeLogReservedNames(t *testing.T) {
+	contract := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	abiJSON := `[{"anonymous":false,"inputs":[{"indexed":false,"name":"contract","type":"uint256"},{"indexed":false,"name":"logIndex","type":"uint256"},{"indexed":false,"name":"","type":"uint256"}],"name":"Spoof","type":"event"}]`
+
+	contractABI := NewContractABI(contract, "Spoof", abiJSON)
+	parsed, err := contractABI.ParseABI()
+	require.NoError(t, err)
+
+	var data []byte
+	for _, v := range []int64{1, 2, 3} {
+		data = append(data, common.LeftPadBytes(big.NewInt(v).Bytes(), 32)...)
+	}
+	log := &Log{
+		Address: contract.Hex(),
+		Topics:  []string{parsed.Events["Spoof"].ID.Hex()},
+		Data:    data,
+		TxHash:  common.HexToHash("0x01").Hex(),
+		Index:   7,
+	}
+
+	event, ok, err := contractABI.DecodeLog(parsed, log)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the arguments named after reserved keys don't override them
+	attrs := make(map[string]string)
+	for _, attr := range event.Attributes {
+		_, dup := attrs[attr.Key]
+		require.False(t, dup, attr.Key)
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, contract.Hex(), attrs[AttributeKeyContractAddress])
+	require.Equal(t, "7", attrs[AttributeKeyLogIndex])
+	require.Equal(t, "1", attrs[ContractABIArgumentPrefix+"contract"])
+	require.Equal(t, "2", attrs[ContractABIArgumentPrefix+"logIndex"])
+	require.Equal(t, "3", attrs[ContractABIArgumentPrefix+"arg2"])
+}
+
+func TestFormatABIValue(t *testing.T) {
+	require.Equal(t, "0x0102", FormatABIValue([]byte{1, 2}))
+	require.Equal(t, "0x0a0b", FormatABIValue([2]byte{10, 11}))
+	require.Equal(t, "42", FormatABIValue(uint8(42)))
+	require.Equal(t, "-1", FormatABIValue(int64(-1)))
+	require.Equal(t, "true", FormatABIValue(true))
+	require.Equal(t, "[1,2]", FormatABIValue([]*big.Int{big.NewInt(1), big.NewInt(2)}))
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/utils"
+)
+
+// NewContractCreation creates a new ContractCreation instance.
+func NewContractCreation(address, creator common.Address, txHash, initCodeHash common.Hash, height int64) ContractCreation {
+	return ContractCreation{
+		Address:      address.Hex(),
+		Creator:      creator.Hex(),
+		TxHash:       txHash.Hex(),
+		Height:       height,
+		InitCodeHash: initCodeHash.Hex(),
+	}
+}
+
+// Validate performs a stateless validation of the contract creation fields.
+func (c ContractCreation) Validate() error {
+	if err := utils.ValidateNonZeroAddress(c.Address); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	if err := utils.ValidateAddress(c.Creator); err != nil {
+		return fmt.Errorf("invalid creator: %w", err)
+	}
+
+	if err := validateHexHash(c.TxHash); err != nil {
+		return fmt.Errorf("invalid tx hash: %w", err)
+	}
+
+	// the init code hash is not known for the records created before it was
+	// recorded
+	if c.InitCodeHash != "" {
+		if err := validateHexHash(c.InitCodeHash); err != nil {
+			return fmt.Errorf("invalid init code hash: %w", err)
+		}
+	}
+
+	if c.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", c.Height)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractCreation_Validate(t *testing.T) {
+	contract := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	creator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	txHash := common.HexToHash("0x01")
+	initCodeHash := common.HexToHash("0x02")
+
+	tests := []struct {
+		name     string
+		creation ContractCreation
+		errorMsg string
+	}{
+		{
+			name:     "valid contract creation",
+			creation: NewContractCreation(contract, creator, txHash, initCodeHash, 10),
+		},
+		{
+			name:     "zero contract address",
+			creation: NewContractCreation(common.Address{}, creator, txHash, initCodeHash, 10),
+			errorMsg: "invalid contract address",
+		},
+		{
+			name: "invalid creator",
+			creation: ContractCreation{
+				Address: contract.Hex(),
+				Creator: "creator",
+				TxHash:  txHash.Hex(),
+				Height:  10,
+			},
+			errorMsg: "invalid creator",
+		},
+		{
+			name: "invalid tx hash",
+			creation: ContractCreation{
+				Address: contract.Hex(),
+				Creator: creator.Hex(),
+				TxHash:  "0x01",
+				Height:  10,
+			},
+			errorMsg: "invalid tx hash",
+		},
+		{
+			name:     "negative height",
+			creation: NewContractCreation(contract, creator, txHash, initCodeHash, -1),
+			errorMsg: "height cannot be negative",
+		},
+		{
+			name: "record without init code hash",
+			creation: ContractCreation{
+				Address: contract.Hex(),
+				Creator: creator.Hex(),
+				TxHash:  txHash.Hex(),
+				Height:  10,
+			},
+		},
+		{
+			name: "invalid init code hash",
+			creation: ContractCreation{
+				Address:      contract.Hex(),
+				Creator:      creator.Hex(),
+				TxHash:       txHash.Hex(),
+				Height:       10,
+				InitCodeHash: "0x02",
+			},
+			errorMsg: "invalid init code hash",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.creation.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
@@ -32,6 +32,9 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrInvalidContractABI
+	codeErrInvalidForkSchedule
+	codeErrInvalidCodeMetadata
 )
 
 var (
@@ -92,6 +95,15 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrInvalidContractABI returns an error if a contract ABI registration is invalid
+	ErrInvalidContractABI = errorsmod.Register(ModuleName, codeErrInvalidContractABI, "invalid contract abi")
+
+	// ErrInvalidForkSchedule returns an error if a hard fork schedule is invalid
+	ErrInvalidForkSchedule = errorsmod.Register(ModuleName, codeErrInvalidForkSchedule, "invalid fork schedule")
+
+	// ErrInvalidCodeMetadata returns an error if a code metadata registration is invalid
+	ErrInvalidCodeMetadata = errorsmod.Register(ModuleName, codeErrInvalidCodeMetadata, "invalid code metadata")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -6,6 +6,10 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeFeeMarket  = "evm_fee_market"
 
+	EventTypeScheduleEVMFork      = "schedule_evm_fork"
+	EventTypeCancelEVMFork        = "cancel_evm_fork"
+	EventTypeRegisterCodeMetadata = "register_code_metadata"
+
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -15,6 +19,9 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeyFork            = "fork"
+	AttributeKeyActivationTime  = "activationTime"
+	AttributeKeyCodeHash        = "codeHash"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
@@ -927,6 +927,14 @@ type Preinstall struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// code in hex format for the preinstall contract
 	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// version of the preinstalled contract release, e.g. 0.7.0
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// source references the verified source of the contract, e.g. the URL of
+	// the tagged source file
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// code_hash is the expected keccak256 hash in hex format of the code. If set,
+	// the preinstall is rejected when the code does not match it.
+	CodeHash string `protobuf:"bytes,6,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
 func (m *Preinstall) Reset()         { *m = Preinstall{} }
@@ -983,6 +991,350 @@ func (m *Preinstall) GetCode() string {
 	return ""
 }
 
+func (m *Preinstall) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Preinstall) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Preinstall) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// ContractABI defines a contract whose logs are decoded with the given ABI and
+// additionally emitted as typed Cosmos events of the form
+// evm.<name>.<EventName>, with one attribute per event argument keyed
+// arg.<argument name>.
+type ContractABI struct {
+	// address in hex format of the contract emitting the logs
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// name of the contract, used as the middle segment of the event type
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// abi is the JSON encoded contract ABI. Only the event entries are used.
+	Abi string `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+func (m *ContractABI) Reset()         { *m = ContractABI{} }
+func (m *ContractABI) String() string { return proto.CompactTextString(m) }
+func (*ContractABI) ProtoMessage()    {}
+func (*ContractABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
+}
+func (m *ContractABI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractABI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractABI.Merge(m, src)
+}
+func (m *ContractABI) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractABI proto.InternalMessageInfo
+
+func (m *ContractABI) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractABI) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractABI) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+// EVMForkSchedule defines the governance scheduled activation of a
+// timestamp based Ethereum hard fork.
+type EVMForkSchedule struct {
+	// fork is the lowercase name of the Ethereum hard fork (e.g. osaka)
+	Fork string `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+	// activation_time is the block timestamp (unix seconds) from which the fork
+	// is active
+	ActivationTime uint64 `protobuf:"varint,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
+}
+
+func (m *EVMForkSchedule) Reset()         { *m = EVMForkSchedule{} }
+func (m *EVMForkSchedule) String() string { return proto.CompactTextString(m) }
+func (*EVMForkSchedule) ProtoMessage()    {}
+func (*EVMForkSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
+}
+func (m *EVMForkSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMForkSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMForkSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMForkSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMForkSchedule.Merge(m, src)
+}
+func (m *EVMForkSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMForkSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMForkSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMForkSchedule proto.InternalMessageInfo
+
+func (m *EVMForkSchedule) GetFork() string {
+	if m != nil {
+		return m.Fork
+	}
+	return ""
+}
+
+func (m *EVMForkSchedule) GetActivationTime() uint64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
+// CodeMetadata defines the verified deployment metadata of a contract code,
+// indexed by the code hash.
+type CodeMetadata struct {
+	// code_hash is the hex encoded keccak256 hash of the runtime bytecode
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// compiler_version is the version of the compiler used to build the code
+	// (e.g. solc 0.8.28+commit.7893614a)
+	CompilerVersion string `protobuf:"bytes,2,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+	// abi is the JSON encoded contract ABI
+	Abi string `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	// source_hash is the hex encoded hash of the verified source bundle
+	SourceHash string `protobuf:"bytes,4,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty"`
+	// submitter is the bech32 address of the account that registered the
+	// metadata
+	Submitter string `protobuf:"bytes,7,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// height is the block height at which the metadata was registered
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// contract_address is the hex address of the contract the code was verified
+	// against
+	ContractAddress string `protobuf:"bytes,9,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// creation_verified is true if the creation bytecode and constructor
+	// arguments were checked against the creation record of the contract, false
+	// if only the runtime bytecode was checked (e.g. for genesis contracts)
+	CreationVerified bool `protobuf:"varint,10,opt,name=creation_verified,json=creationVerified,proto3" json:"creation_verified,omitempty"`
+}
+
+func (m *CodeMetadata) Reset()         { *m = CodeMetadata{} }
+func (m *CodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadata) ProtoMessage()    {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{14}
+}
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
+func (m *CodeMetadata) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CodeMetadata) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetCreationVerified() bool {
+	if m != nil {
+		return m.CreationVerified
+	}
+	return false
+}
+
+// ContractCreation defines the record of the deployment of a contract.
+type ContractCreation struct {
+	// address is the hex address of the created contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// creator is the hex address of the account that executed the CREATE or
+	// CREATE2 operation, i.e. the transaction sender or a factory contract
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// tx_hash is the hex encoded hash of the creation transaction
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// height is the block height of the creation transaction
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// init_code_hash is the hex encoded keccak256 hash of the init code of the
+	// creation, i.e. the creation bytecode followed by the constructor arguments
+	InitCodeHash string `protobuf:"bytes,5,opt,name=init_code_hash,json=initCodeHash,proto3" json:"init_code_hash,omitempty"`
+}
+
+func (m *ContractCreation) Reset()         { *m = ContractCreation{} }
+func (m *ContractCreation) String() string { return proto.CompactTextString(m) }
+func (*ContractCreation) ProtoMessage()    {}
+func (*ContractCreation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{15}
+}
+func (m *ContractCreation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCreation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCreation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCreation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCreation.Merge(m, src)
+}
+func (m *ContractCreation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCreation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCreation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCreation proto.InternalMessageInfo
+
+func (m *ContractCreation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractCreation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ContractCreation) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ContractCreation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractCreation) GetInitCodeHash() string {
+	if m != nil {
+		return m.InitCodeHash
+	}
+	return ""
+}
+
 type EvmCoinInfo struct {
 	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	ExtendedDenom string `protobuf:"bytes,2,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
@@ -994,7 +1346,7 @@ func (m *EvmCoinInfo) Reset()         { *m = EvmCoinInfo{} }
 func (m *EvmCoinInfo) String() string { return proto.CompactTextString(m) }
 func (*EvmCoinInfo) ProtoMessage()    {}
 func (*EvmCoinInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{12}
+	return fileDescriptor_d1129b8db63d55c7, []int{16}
 }
 func (m *EvmCoinInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1065,145 +1417,166 @@ func init() {
 	proto.RegisterType((*AccessTuple)(nil), "cosmos.evm.vm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "cosmos.evm.vm.v1.TraceConfig")
 	proto.RegisterType((*Preinstall)(nil), "cosmos.evm.vm.v1.Preinstall")
+	proto.RegisterType((*ContractABI)(nil), "cosmos.evm.vm.v1.ContractABI")
+	proto.RegisterType((*EVMForkSchedule)(nil), "cosmos.evm.vm.v1.EVMForkSchedule")
+	proto.RegisterType((*CodeMetadata)(nil), "cosmos.evm.vm.v1.CodeMetadata")
+	proto.RegisterType((*ContractCreation)(nil), "cosmos.evm.vm.v1.ContractCreation")
 	proto.RegisterType((*EvmCoinInfo)(nil), "cosmos.evm.vm.v1.EvmCoinInfo")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x4d,
-	0x5b, 0xa8, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0x98, 0x56, 0xaa, 0x6c,
-	0x0b, 0x43, 0x35, 0x46, 0x8a, 0x14, 0x8b, 0xe1, 0xee, 0x78, 0xb9, 0xd1, 0xee, 0x0e, 0xb1, 0xb3,
-	0xa4, 0xc5, 0xfe, 0x81, 0x06, 0xee, 0x25, 0xed, 0xdd, 0x40, 0x80, 0x5e, 0x72, 0xcc, 0x4f, 0xe8,
-	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c, 0xf0,
-	0x5b, 0x61, 0x15, 0x40, 0xb0, 0xe7, 0x79, 0x3f, 0x9e, 0x67, 0x3e, 0xde, 0xdd, 0x79, 0x97, 0xa0,
-	0xe2, 0x50, 0x16, 0x52, 0xb6, 0x4d, 0x3a, 0xe1, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x56, 0x4c,
-	0x13, 0x0a, 0x0d, 0xe9, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0xb9, 0x85, 0x43, 0x3f, 0xa2, 0xdb,
-	0xe2, 0x5f, 0x19, 0x54, 0x29, 0x79, 0xd4, 0xa3, 0x62, 0xb8, 0xcd, 0x47, 0xd2, 0x6a, 0xfd, 0x5d,
-	0x03, 0x8b, 0xa7, 0x38, 0xc6, 0x21, 0x83, 0x3b, 0x20, 0x47, 0x3a, 0xa1, 0xed, 0x92, 0x88, 0x86,
-	0xe5, 0xcc, 0x46, 0x66, 0x33, 0x57, 0x2d, 0x5d, 0xa5, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xc4, 0x1a,
-	0xb8, 0x2c, 0xa4, 0x93, 0x4e, 0x78, 0xc8, 0x87, 0x70, 0x1f, 0x00, 0x72, 0x91, 0xc4, 0xd8, 0x26,
-	0x7e, 0x8b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0xf5, 0x52, 0x33, 0x57, 0xe3, 0xd6, 0xda,
-	0xd1, 0x29, 0xbb, 0x4a, 0xcd, 0x5b, 0x8a, 0x60, 0x10, 0x68, 0xa1, 0x9c, 0x00, 0x35, 0xbf, 0xc5,
-	0xe0, 0x2e, 0x28, 0x70, 0x6a, 0xa7, 0x89, 0xa3, 0x88, 0x04, 0xac, 0xbc, 0xb4, 0x91, 0xdd, 0xcc,
-	0x55, 0x57, 0x7a, 0xa9, 0x99, 0xaf, 0x7d, 0xfc, 0xf4, 0x40, 0x99, 0x51, 0x9e, 0x74, 0xc2, 0x3e,
-	0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x51, 0x12, 0xd3, 0xa0, 0xac, 0x6f,
-	0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xe4, 0x46, 0x6c, 0xed, 0x8b, 0xb8, 0x03, 0x19, 0x56, 0xbd,
-	0xfd, 0x4d, 0x6a, 0xce, 0xf5, 0x52, 0x73, 0x79, 0xcc, 0x8c, 0x96, 0xf1, 0x28, 0x84, 0x4f, 0xc0,
-	0x5d, 0xec, 0x24, 0x7e, 0x87, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0xad, 0x98, 0x38, 0x34, 0x6c,
-	0xf9, 0x01, 0x61, 0xe5, 0x1c, 0x9f, 0x1f, 0xba, 0x23, 0x03, 0xea, 0xc2, 0x7f, 0x3a, 0x74, 0xc3,
-	0x87, 0xa0, 0xd4, 0xf4, 0x59, 0x42, 0xe3, 0xae, 0xcd, 0x48, 0xdc, 0x21, 0xf6, 0x2b, 0x3f, 0x72,
-	0xe9, 0xab, 0x32, 0xd8, 0xc8, 0x6c, 0x6a, 0x08, 0x2a, 0x5f, 0x9d, 0xbb, 0x5e, 0x08, 0x0f, 0xfc,
-	0x14, 0xac, 0x91, 0x8b, 0x84, 0x44, 0x2e, 0x71, 0xe5, 0x06, 0xdb, 0xb4, 0x95, 0xf8, 0x34, 0x62,
-	0xe5, 0xbc, 0x58, 0xd4, 0x4f, 0xa7, 0x17, 0x55, 0x53, 0xf1, 0xe2, 0x10, 0x9e, 0xcb, 0x68, 0x54,
-	0x22, 0xd7, 0x58, 0x9f, 0xdc, 0x7b, 0xfd, 0xdd, 0xd7, 0x0f, 0xd6, 0x46, 0x6a, 0xe7, 0x82, 0x57,
-	0x8f, 0x3c, 0xf1, 0x63, 0x4d, 0x9f, 0x37, 0xb2, 0xc7, 0x9a, 0x9e, 0x35, 0xb4, 0x63, 0x4d, 0x5f,
-	0x30, 0x16, 0x8f, 0x35, 0x7d, 0xd1, 0x58, 0xb2, 0x3e, 0x04, 0xa5, 0xeb, 0x24, 0xe0, 0x4f, 0x40,
-	0x71, 0x7c, 0xaa, 0xb2, 0x4c, 0xd0, 0xf2, 0x98, 0xb4, 0xf5, 0xb7, 0x0c, 0x18, 0xdf, 0x60, 0xb8,
-	0x0f, 0x16, 0x9d, 0x98, 0xe0, 0x84, 0x88, 0x84, 0xfc, 0xee, 0xbb, 0xff, 0xe7, 0xa0, 0xce, 0xba,
-	0x2d, 0x52, 0xd5, 0xf8, 0x61, 0x21, 0x95, 0x08, 0x3f, 0x04, 0x9a, 0x83, 0x83, 0xa0, 0x3c, 0xff,
-	0x43, 0x09, 0x44, 0x9a, 0xf5, 0x9f, 0x0c, 0xb8, 0x35, 0x15, 0x01, 0x1d, 0x90, 0x57, 0x85, 0x94,
-	0x74, 0x5b, 0x72, 0x72, 0xc5, 0xdd, 0xfb, 0xdf, 0xc7, 0x2d, 0x48, 0x7f, 0xdc, 0x4b, 0x4d, 0x30,
-	0xc4, 0x57, 0xa9, 0x09, 0x65, 0x7d, 0x8f, 0x10, 0x59, 0x08, 0xe0, 0x41, 0x04, 0x74, 0xc0, 0xea,
-	0x78, 0xb5, 0xda, 0x81, 0xcf, 0x92, 0xf2, 0xbc, 0x28, 0xf4, 0x47, 0xbd, 0xd4, 0x1c, 0x9f, 0xd8,
-	0x89, 0xcf, 0x92, 0xab, 0xd4, 0xac, 0x8c, 0xb1, 0x8e, 0x66, 0x5a, 0xe8, 0x16, 0x9e, 0x4c, 0xb0,
-	0xbe, 0x32, 0x40, 0xfe, 0xa0, 0x89, 0xfd, 0xe8, 0x80, 0x46, 0x2f, 0x7d, 0x0f, 0x7e, 0x0a, 0x56,
-	0x9a, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x1b, 0x01, 0x75, 0xce, 0xd5, 0x23, 0xfd, 0xe8, 0xdf,
-	0xa9, 0x79, 0x5b, 0x2e, 0x90, 0xb9, 0xe7, 0x5b, 0x3e, 0xdd, 0x0e, 0x71, 0xd2, 0xdc, 0x3a, 0x8a,
-	0xb8, 0xe8, 0x9a, 0x14, 0x9d, 0xc8, 0xb4, 0x50, 0x71, 0x60, 0xa9, 0x72, 0x03, 0x6c, 0x82, 0xa2,
-	0x8b, 0xa9, 0xfd, 0x92, 0xc6, 0xe7, 0x8a, 0x7c, 0x5e, 0x90, 0x57, 0xbf, 0x97, 0xbc, 0x97, 0x9a,
-	0x85, 0xc3, 0xfd, 0xe7, 0x1f, 0xd1, 0xf8, 0x5c, 0x50, 0x5c, 0xa5, 0xe6, 0x6d, 0x29, 0x36, 0x4e,
-	0x64, 0xa1, 0x82, 0x8b, 0xe9, 0x20, 0x0c, 0xbe, 0x00, 0xc6, 0x20, 0x80, 0xb5, 0x5b, 0x2d, 0x1a,
-	0x27, 0xe5, 0xec, 0x46, 0x66, 0x53, 0xaf, 0xfe, 0xbc, 0x97, 0x9a, 0x45, 0x45, 0x59, 0x97, 0x9e,
-	0xab, 0xd4, 0xbc, 0x33, 0x41, 0xaa, 0x72, 0x2c, 0x54, 0x54, 0xb4, 0x2a, 0x14, 0x36, 0x40, 0x81,
-	0xf8, 0xad, 0x9d, 0xbd, 0x87, 0x6a, 0x01, 0x9a, 0x58, 0xc0, 0x6f, 0x66, 0x2d, 0x20, 0x5f, 0x3b,
-	0x3a, 0xdd, 0xd9, 0x7b, 0xd8, 0x9f, 0xff, 0xaa, 0x7a, 0xaf, 0x8d, 0xb0, 0x58, 0x28, 0x2f, 0xa1,
-	0x9c, 0x7c, 0x5f, 0x63, 0x4f, 0x69, 0x2c, 0xde, 0x54, 0x63, 0xef, 0x3a, 0x8d, 0xbd, 0x71, 0x8d,
-	0xbd, 0x71, 0x8d, 0xc7, 0x4a, 0x63, 0xe9, 0xa6, 0x1a, 0x8f, 0xaf, 0xd3, 0x78, 0x3c, 0xae, 0x21,
-	0x63, 0x78, 0x31, 0x35, 0xba, 0x7f, 0xc6, 0x51, 0xe2, 0xb7, 0x43, 0x25, 0xa3, 0xdf, 0xb8, 0x98,
-	0x26, 0x32, 0x2d, 0x54, 0x1c, 0x58, 0x24, 0xfb, 0x39, 0x28, 0x39, 0x34, 0x62, 0x09, 0xb7, 0x45,
-	0xb4, 0x15, 0x10, 0x25, 0x91, 0x13, 0x12, 0x8f, 0x67, 0x49, 0xdc, 0x93, 0x12, 0xd7, 0xa5, 0x5b,
-	0x68, 0x75, 0xdc, 0x2c, 0xc5, 0x6c, 0x60, 0xb4, 0x48, 0x42, 0x62, 0xd6, 0x68, 0xc7, 0x9e, 0x12,
-	0x02, 0x42, 0xe8, 0xfd, 0x59, 0x42, 0xaa, 0xac, 0x26, 0x53, 0x2d, 0xb4, 0x32, 0x34, 0x49, 0x81,
-	0x4f, 0x40, 0xd1, 0xe7, 0xaa, 0x8d, 0x76, 0xa0, 0xe8, 0xf3, 0x82, 0x7e, 0x77, 0x16, 0xbd, 0x7a,
-	0x14, 0xc6, 0x13, 0x2d, 0xb4, 0xdc, 0x37, 0x48, 0x6a, 0x17, 0xc0, 0xb0, 0xed, 0xc7, 0xb6, 0x17,
-	0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x5f, 0xcc, 0xa2, 0xbf, 0x2b, 0xe9, 0xa7, 0x93,
-	0x2d, 0x64, 0x70, 0xe3, 0x6f, 0xa5, 0x4d, 0xaa, 0xd4, 0x41, 0xa1, 0x41, 0xe2, 0xc0, 0x8f, 0x14,
-	0xff, 0xb2, 0xe0, 0x7f, 0x38, 0x8b, 0x5f, 0x55, 0xd0, 0x68, 0x9a, 0x85, 0xf2, 0x12, 0x0e, 0x48,
-	0x03, 0x1a, 0xb9, 0xb4, 0x4f, 0x7a, 0xeb, 0xc6, 0xa4, 0xa3, 0x69, 0x16, 0xca, 0x4b, 0x28, 0x49,
-	0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0xd8, 0x10, 0x28, 0xb8, 0x7f, 0x39, 0x8b, 0xbb, 0xff,
-	0x72, 0x9d, 0xce, 0xe6, 0x2f, 0x57, 0x6e, 0x1d, 0xdb, 0x12, 0x17, 0x40, 0x2f, 0xc6, 0xdd, 0x09,
-	0x9d, 0xd2, 0x8d, 0x37, 0x7e, 0x3a, 0xd9, 0x42, 0x06, 0x37, 0x8e, 0xa9, 0x7c, 0x06, 0x4a, 0x21,
-	0x89, 0x3d, 0x62, 0x47, 0x24, 0x61, 0xad, 0xc0, 0x4f, 0x94, 0xce, 0xed, 0x1b, 0x3f, 0x07, 0xd7,
-	0xa5, 0x5b, 0x08, 0x0a, 0xf3, 0x33, 0x65, 0x95, 0x5a, 0x77, 0x81, 0xee, 0xf0, 0xdb, 0xc2, 0xf6,
-	0xdd, 0x72, 0x59, 0xb4, 0x26, 0x4b, 0x02, 0x1f, 0xb9, 0xb0, 0x04, 0x16, 0xe4, 0xdd, 0x7e, 0x57,
-	0xdc, 0xed, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x12, 0x06,
-	0x18, 0x7e, 0x0c, 0x96, 0x59, 0x13, 0x47, 0x5e, 0x13, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x4f,
-	0xcc, 0x78, 0x67, 0xd6, 0x8c, 0x4b, 0x72, 0xc6, 0x63, 0x79, 0x16, 0x2a, 0xf4, 0xf1, 0x99, 0x1f,
-	0x12, 0x78, 0x0a, 0xf2, 0x0e, 0x8e, 0x9c, 0x76, 0x24, 0x59, 0xef, 0x0b, 0xd6, 0xed, 0x59, 0xac,
-	0xea, 0x2a, 0x1e, 0xc9, 0xb2, 0x10, 0x90, 0xa8, 0xcf, 0xd8, 0x8a, 0xb1, 0xd7, 0x26, 0x92, 0xf1,
-	0x9d, 0x1b, 0x33, 0x8e, 0x64, 0x59, 0x08, 0x48, 0xd4, 0x67, 0xec, 0x90, 0xf8, 0x3c, 0x50, 0x8c,
-	0xeb, 0x37, 0x66, 0x1c, 0xc9, 0xb2, 0x10, 0x90, 0x48, 0x30, 0x3e, 0x05, 0x80, 0x32, 0x7c, 0x8e,
-	0x25, 0xa1, 0x29, 0x08, 0xb7, 0x66, 0x11, 0xaa, 0xfe, 0x7a, 0x98, 0x64, 0xa1, 0x9c, 0x00, 0x9c,
-	0x6e, 0xd0, 0xd7, 0xad, 0x19, 0x77, 0x8e, 0x35, 0xfd, 0x8e, 0x51, 0xb6, 0xb6, 0xc1, 0x02, 0xef,
-	0x5b, 0x09, 0x34, 0x40, 0xf6, 0x9c, 0x74, 0x55, 0x0f, 0xc7, 0x87, 0xfc, 0xec, 0x3b, 0x38, 0x68,
-	0x13, 0x79, 0x9d, 0x23, 0x09, 0xac, 0x53, 0xb0, 0x72, 0x16, 0xe3, 0x88, 0xf1, 0x9e, 0x97, 0x46,
-	0x27, 0xd4, 0x63, 0x10, 0x02, 0xad, 0x89, 0x59, 0x53, 0xe5, 0x8a, 0x31, 0xfc, 0x19, 0xd0, 0x02,
-	0xea, 0x31, 0xd1, 0xd8, 0xe4, 0x77, 0x6f, 0x4f, 0x77, 0x51, 0x27, 0xd4, 0x43, 0x22, 0xc4, 0xfa,
-	0x4b, 0x16, 0x64, 0x4f, 0xa8, 0x07, 0xcb, 0x60, 0x09, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x7d,
-	0x08, 0xd7, 0xc0, 0x62, 0x42, 0x5b, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04,
-	0x8b, 0x1e, 0xa0, 0x80, 0xc4, 0x98, 0x7f, 0x42, 0x88, 0x52, 0xb7, 0xa3, 0x76, 0xd8, 0x20, 0xb1,
-	0xb8, 0xca, 0xb5, 0xea, 0xca, 0x65, 0x6a, 0xe6, 0x85, 0xfd, 0x99, 0x30, 0xa3, 0x51, 0x00, 0xdf,
-	0x03, 0x4b, 0xc9, 0x85, 0x2d, 0xd6, 0xb0, 0x20, 0xb6, 0x78, 0xf5, 0x32, 0x35, 0x57, 0x92, 0xe1,
-	0x32, 0x7f, 0x87, 0x59, 0x13, 0x2d, 0x26, 0x17, 0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xe4, 0xc2, 0xf6,
-	0x23, 0x97, 0x5c, 0x88, 0x4b, 0x5c, 0xab, 0x96, 0x2e, 0x53, 0xd3, 0x18, 0x09, 0x3f, 0xe2, 0x3e,
-	0xb4, 0x94, 0x5c, 0x88, 0x01, 0x7c, 0x0f, 0x00, 0x39, 0x25, 0xa1, 0x20, 0xef, 0xe4, 0xe5, 0xcb,
-	0xd4, 0xcc, 0x09, 0xab, 0xe0, 0x1e, 0x0e, 0xa1, 0x05, 0x16, 0x24, 0xb7, 0x2e, 0xb8, 0x0b, 0x97,
-	0xa9, 0xa9, 0x07, 0xd4, 0x93, 0x9c, 0xd2, 0xc5, 0xb7, 0x2a, 0x26, 0x21, 0xed, 0x10, 0x57, 0x5c,
-	0x8c, 0x3a, 0xea, 0x43, 0xf8, 0x01, 0x58, 0x91, 0x5a, 0xfc, 0xec, 0x59, 0x82, 0xc3, 0x96, 0xfc,
-	0xda, 0xa8, 0xc2, 0xcb, 0xd4, 0x2c, 0x0a, 0xd7, 0x59, 0xdf, 0x83, 0x26, 0xb0, 0xf5, 0xc5, 0x3c,
-	0xd0, 0xcf, 0x2e, 0x10, 0x61, 0xed, 0x20, 0x81, 0x1f, 0x01, 0x43, 0x34, 0x9a, 0xd8, 0x49, 0xec,
-	0xb1, 0x73, 0xa9, 0xde, 0x1b, 0xde, 0x81, 0x93, 0x11, 0x16, 0x5a, 0xe9, 0x9b, 0xf6, 0xd5, 0xe1,
-	0x95, 0xc0, 0x42, 0x23, 0xa0, 0x34, 0x14, 0x65, 0x54, 0x40, 0x12, 0xc0, 0x17, 0x62, 0xcb, 0x45,
-	0x89, 0x64, 0x45, 0x13, 0xff, 0xa3, 0xe9, 0x12, 0x99, 0xa8, 0xb3, 0xea, 0x3d, 0xde, 0xc2, 0x5f,
-	0xa5, 0x66, 0x51, 0x6a, 0xab, 0x7c, 0xeb, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0xe1, 0xa7, 0x23, 0x8a,
-	0xd1, 0x00, 0xd9, 0x98, 0x24, 0xe2, 0xd8, 0x0b, 0x88, 0x0f, 0xf9, 0xdb, 0x2a, 0x26, 0x1d, 0x12,
-	0x27, 0xc4, 0x15, 0xc7, 0xab, 0xa3, 0x01, 0xe6, 0xaf, 0x3e, 0x0f, 0x33, 0xbb, 0xcd, 0x88, 0x2b,
-	0xcf, 0x12, 0x2d, 0x79, 0x98, 0xfd, 0x81, 0x11, 0xf7, 0x89, 0xf6, 0xf9, 0x97, 0xe6, 0x9c, 0x85,
-	0x41, 0x5e, 0xf5, 0xf7, 0xed, 0x56, 0x40, 0x66, 0xd4, 0xe8, 0x2e, 0x28, 0xf0, 0xaf, 0x39, 0xec,
-	0x11, 0xfb, 0x9c, 0x74, 0x55, 0xa5, 0xca, 0xba, 0x53, 0xf6, 0xdf, 0x93, 0x2e, 0x43, 0xa3, 0x40,
-	0x49, 0x7c, 0xa9, 0x81, 0xfc, 0x59, 0x8c, 0x1d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x1c, 0xc6, 0x4a,
-	0x42, 0x21, 0xae, 0xcd, 0x0f, 0x95, 0xb6, 0x13, 0xf5, 0x44, 0xf6, 0x21, 0xcf, 0x88, 0x09, 0xb9,
-	0x20, 0x8e, 0xd8, 0x4b, 0x0d, 0x29, 0x04, 0xf7, 0xc0, 0xb2, 0xeb, 0x33, 0xdc, 0x08, 0xc4, 0xc7,
-	0xab, 0x73, 0x2e, 0x97, 0x5f, 0x35, 0x2e, 0x53, 0xb3, 0xa0, 0x1c, 0x75, 0x6e, 0x47, 0x63, 0x88,
-	0xd7, 0xd0, 0x30, 0x4d, 0xcc, 0x56, 0xec, 0x8d, 0x2e, 0x6b, 0x68, 0x10, 0x2a, 0x3c, 0x68, 0x02,
-	0xcb, 0x1b, 0xa3, 0xd1, 0xf6, 0x44, 0xf9, 0xea, 0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44,
-	0xb9, 0x2e, 0x20, 0x09, 0xe0, 0x07, 0x20, 0x47, 0x3b, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0x51, 0xa6,
-	0xf9, 0xdd, 0x77, 0xa6, 0xcb, 0x60, 0xe4, 0x4b, 0x06, 0x0d, 0xe3, 0xf9, 0xe2, 0x48, 0x24, 0x26,
-	0x19, 0x92, 0x90, 0xc6, 0x5d, 0xd1, 0x5a, 0xa9, 0xc5, 0x49, 0xc7, 0x53, 0x61, 0x47, 0x63, 0x08,
-	0x56, 0x01, 0x54, 0x69, 0x31, 0x49, 0xda, 0x71, 0x64, 0x8b, 0x37, 0x48, 0x41, 0xe4, 0x8a, 0xe7,
-	0x58, 0x7a, 0x91, 0x70, 0x1e, 0xe2, 0x04, 0xa3, 0x29, 0x0b, 0xfc, 0x35, 0x80, 0xf2, 0x4c, 0xec,
-	0xcf, 0x18, 0x8d, 0xf8, 0xf7, 0xd8, 0x4b, 0xdf, 0x53, 0xbd, 0x91, 0xd0, 0x97, 0x5e, 0x35, 0x67,
-	0x43, 0xa2, 0x63, 0x46, 0xd5, 0x2a, 0x8e, 0x35, 0x5d, 0x33, 0x16, 0x8e, 0x35, 0x7d, 0xc9, 0xd0,
-	0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfb, 0x78, 0x64, 0x7a, 0xd6, 0x33, 0x00, 0x4e, 0x63, 0xe2,
-	0xf3, 0x0e, 0x36, 0x08, 0xf8, 0x6b, 0x2f, 0xc2, 0x21, 0xe9, 0xbf, 0x6f, 0xf9, 0x78, 0xb4, 0x30,
-	0xe7, 0xc7, 0x0b, 0x13, 0x02, 0xcd, 0xa1, 0x2e, 0x11, 0xa5, 0x91, 0x43, 0x62, 0x6c, 0xfd, 0x35,
-	0x03, 0xf2, 0xb5, 0x4e, 0x78, 0x40, 0xfd, 0xe8, 0x28, 0x7a, 0x49, 0x87, 0xd7, 0x7c, 0x66, 0xf4,
-	0x9a, 0x9f, 0xfe, 0xc2, 0x9f, 0xbf, 0xe6, 0x0b, 0x1f, 0xbe, 0x2b, 0xaa, 0xac, 0x15, 0xe0, 0xae,
-	0x8a, 0x92, 0x4a, 0x05, 0x65, 0x3c, 0x9c, 0x6a, 0x19, 0xf8, 0xb3, 0xb9, 0x3c, 0x6c, 0x19, 0x1e,
-	0xfc, 0x33, 0x03, 0x46, 0x3e, 0xa2, 0xe1, 0xaf, 0x40, 0x65, 0xff, 0xe0, 0xa0, 0x56, 0xaf, 0xdb,
-	0x67, 0x9f, 0x9c, 0xd6, 0xec, 0xd3, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0x76, 0x52,
-	0xab, 0xd7, 0x8d, 0xb9, 0xca, 0xfd, 0xd7, 0x6f, 0x36, 0xca, 0xc3, 0xf8, 0x53, 0x12, 0x87, 0x3e,
-	0x63, 0x3e, 0x8d, 0x02, 0xbe, 0xdc, 0xf7, 0xc1, 0xda, 0x68, 0x36, 0xaa, 0xd5, 0xcf, 0xd0, 0xd1,
-	0xc1, 0x59, 0xed, 0xd0, 0xc8, 0x54, 0xca, 0xaf, 0xdf, 0x6c, 0x94, 0x86, 0x99, 0x88, 0xb0, 0x24,
-	0xf6, 0x1d, 0xfe, 0x1e, 0x78, 0x0c, 0xca, 0xd7, 0x6b, 0xd6, 0x0e, 0x8d, 0xf9, 0x4a, 0xe5, 0xf5,
-	0x9b, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5b, 0xd1, 0x3e, 0xff, 0xc7, 0xfa, 0x5c, 0xf5, 0xc9, 0x37,
-	0xbd, 0xf5, 0xcc, 0xb7, 0xbd, 0xf5, 0xcc, 0x7f, 0x7b, 0xeb, 0x99, 0x2f, 0xde, 0xae, 0xcf, 0x7d,
-	0xfb, 0x76, 0x7d, 0xee, 0x5f, 0x6f, 0xd7, 0xe7, 0xfe, 0xb8, 0xe1, 0xf9, 0x49, 0xb3, 0xdd, 0xd8,
-	0x72, 0x68, 0xb8, 0x3d, 0xf9, 0xcb, 0x4b, 0xd2, 0x6d, 0x11, 0xd6, 0x58, 0x14, 0x3f, 0xbe, 0x3d,
-	0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x59, 0x0f, 0x70, 0xd5, 0x13, 0x00, 0x00,
+	// 2379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
+	0x15, 0xb7, 0x2c, 0xda, 0xa6, 0x46, 0xb2, 0x4c, 0x8f, 0xb5, 0x5e, 0xad, 0xbc, 0x31, 0x5d, 0x26,
+	0x6d, 0x9d, 0x34, 0xb5, 0xd7, 0x4e, 0xdc, 0x2e, 0x36, 0x4d, 0x0b, 0x4b, 0x56, 0x5a, 0xbb, 0xeb,
+	0x8d, 0x31, 0x72, 0x77, 0x91, 0x22, 0x05, 0x31, 0x22, 0x67, 0x25, 0xc6, 0x24, 0x47, 0xe0, 0x50,
+	0x5a, 0xbb, 0x5f, 0xa0, 0xc1, 0xf6, 0x92, 0xf6, 0xbe, 0x40, 0x80, 0x5c, 0x72, 0xcc, 0x47, 0xe8,
+	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x15, 0x0a, 0xef, 0x21, 0x80, 0x8f, 0xfe, 0x04, 0xc5, 0xfc, 0xa1,
+	0x24, 0x4a, 0x8e, 0xea, 0x02, 0x82, 0x3d, 0xef, 0xcd, 0x7b, 0xbf, 0xdf, 0x9b, 0x99, 0xc7, 0x99,
+	0x37, 0x03, 0x2a, 0x0e, 0x65, 0x01, 0x65, 0xdb, 0xa4, 0x17, 0x6c, 0xf3, 0xdf, 0x0e, 0x6f, 0x6d,
+	0x75, 0x22, 0x1a, 0x53, 0x68, 0xc8, 0xbe, 0x2d, 0xae, 0xe1, 0xbf, 0x9d, 0xca, 0x32, 0x0e, 0xbc,
+	0x90, 0x6e, 0x8b, 0xbf, 0xd2, 0xa8, 0x52, 0x6a, 0xd1, 0x16, 0x15, 0xcd, 0x6d, 0xde, 0x92, 0x5a,
+	0xeb, 0xef, 0x1a, 0x98, 0x3f, 0xc1, 0x11, 0x0e, 0x18, 0xdc, 0x01, 0x39, 0xd2, 0x0b, 0x6c, 0x97,
+	0x84, 0x34, 0x28, 0x67, 0x36, 0x32, 0x9b, 0xb9, 0x6a, 0xe9, 0xba, 0x6f, 0x1a, 0x17, 0x38, 0xf0,
+	0x1f, 0x59, 0x83, 0x2e, 0x0b, 0xe9, 0xa4, 0x17, 0x1c, 0xf0, 0x26, 0xdc, 0x07, 0x80, 0x9c, 0xc7,
+	0x11, 0xb6, 0x89, 0xd7, 0x61, 0x65, 0x6d, 0x23, 0xbb, 0x99, 0xad, 0x5a, 0x97, 0x7d, 0x33, 0x57,
+	0xe7, 0xda, 0xfa, 0xe1, 0x09, 0xbb, 0xee, 0x9b, 0xcb, 0x0a, 0x60, 0x60, 0x68, 0xa1, 0x9c, 0x10,
+	0xea, 0x5e, 0x87, 0xc1, 0x5d, 0x50, 0xe0, 0xd0, 0x4e, 0x1b, 0x87, 0x21, 0xf1, 0x59, 0x79, 0x61,
+	0x23, 0xbb, 0x99, 0xab, 0x2e, 0x5d, 0xf6, 0xcd, 0x7c, 0xfd, 0xe9, 0x71, 0x4d, 0xa9, 0x51, 0x9e,
+	0xf4, 0x82, 0x44, 0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x61, 0x1c, 0x51,
+	0xbf, 0xac, 0x6f, 0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xf8, 0x44, 0x6c, 0xed, 0x0b, 0xbb, 0x9a,
+	0x34, 0xab, 0xde, 0xf9, 0xb6, 0x6f, 0xce, 0x5c, 0xf6, 0xcd, 0xc5, 0x94, 0x1a, 0x2d, 0xe2, 0x51,
+	0x11, 0x3e, 0x02, 0xf7, 0xb0, 0x13, 0x7b, 0x3d, 0x62, 0xb3, 0x18, 0xc7, 0x9e, 0x63, 0x77, 0x22,
+	0xe2, 0xd0, 0xa0, 0xe3, 0xf9, 0x84, 0x95, 0x73, 0x3c, 0x3e, 0x74, 0x57, 0x1a, 0x34, 0x44, 0xff,
+	0xc9, 0xb0, 0x1b, 0x3e, 0x00, 0xa5, 0xb6, 0xc7, 0x62, 0x1a, 0x5d, 0xd8, 0x8c, 0x44, 0x3d, 0x62,
+	0xbf, 0xf0, 0x42, 0x97, 0xbe, 0x28, 0x83, 0x8d, 0xcc, 0xa6, 0x86, 0xa0, 0xea, 0x6b, 0xf0, 0xae,
+	0x67, 0xa2, 0x07, 0x7e, 0x0a, 0x56, 0xc9, 0x79, 0x4c, 0x42, 0x97, 0xb8, 0x72, 0x82, 0x6d, 0xda,
+	0x89, 0x3d, 0x1a, 0xb2, 0x72, 0x5e, 0x0c, 0xea, 0x27, 0x93, 0x83, 0xaa, 0x2b, 0x7b, 0xb1, 0x08,
+	0x1f, 0x4b, 0x6b, 0x54, 0x22, 0x37, 0x68, 0x1f, 0xad, 0xbd, 0xfc, 0xfe, 0x9b, 0x77, 0x56, 0x47,
+	0x72, 0xe7, 0x9c, 0x67, 0x8f, 0x5c, 0xf1, 0x23, 0x4d, 0x9f, 0x35, 0xb2, 0x47, 0x9a, 0x9e, 0x35,
+	0xb4, 0x23, 0x4d, 0x9f, 0x33, 0xe6, 0x8f, 0x34, 0x7d, 0xde, 0x58, 0xb0, 0x3e, 0x04, 0xa5, 0x9b,
+	0x28, 0xe0, 0x8f, 0x41, 0x31, 0x1d, 0xaa, 0x4c, 0x13, 0xb4, 0x98, 0xa2, 0xb6, 0xfe, 0x96, 0x01,
+	0xe9, 0x09, 0x86, 0xfb, 0x60, 0xde, 0x89, 0x08, 0x8e, 0x89, 0x70, 0xc8, 0xef, 0xbe, 0xf9, 0x3f,
+	0x16, 0xea, 0xf4, 0xa2, 0x43, 0xaa, 0x1a, 0x5f, 0x2c, 0xa4, 0x1c, 0xe1, 0x87, 0x40, 0x73, 0xb0,
+	0xef, 0x97, 0x67, 0xff, 0x5f, 0x00, 0xe1, 0x66, 0xfd, 0x3b, 0x03, 0x96, 0x27, 0x2c, 0xa0, 0x03,
+	0xf2, 0x2a, 0x91, 0xe2, 0x8b, 0x8e, 0x0c, 0xae, 0xb8, 0x7b, 0xff, 0x87, 0xb0, 0x05, 0xe8, 0x5b,
+	0x97, 0x7d, 0x13, 0x0c, 0xe5, 0xeb, 0xbe, 0x09, 0x65, 0x7e, 0x8f, 0x00, 0x59, 0x08, 0xe0, 0x81,
+	0x05, 0x74, 0xc0, 0x4a, 0x3a, 0x5b, 0x6d, 0xdf, 0x63, 0x71, 0x79, 0x56, 0x24, 0xfa, 0x7b, 0x97,
+	0x7d, 0x33, 0x1d, 0xd8, 0x63, 0x8f, 0xc5, 0xd7, 0x7d, 0xb3, 0x92, 0x42, 0x1d, 0xf5, 0xb4, 0xd0,
+	0x32, 0x1e, 0x77, 0xb0, 0xbe, 0x36, 0x40, 0xbe, 0xd6, 0xc6, 0x5e, 0x58, 0xa3, 0xe1, 0x73, 0xaf,
+	0x05, 0x3f, 0x05, 0x4b, 0x6d, 0x1a, 0x10, 0x16, 0x13, 0xec, 0xda, 0x4d, 0x9f, 0x3a, 0x67, 0xea,
+	0x93, 0x7e, 0xef, 0x5f, 0x7d, 0xf3, 0x8e, 0x1c, 0x20, 0x73, 0xcf, 0xb6, 0x3c, 0xba, 0x1d, 0xe0,
+	0xb8, 0xbd, 0x75, 0x18, 0x72, 0xd2, 0x55, 0x49, 0x3a, 0xe6, 0x69, 0xa1, 0xe2, 0x40, 0x53, 0xe5,
+	0x0a, 0xd8, 0x06, 0x45, 0x17, 0x53, 0xfb, 0x39, 0x8d, 0xce, 0x14, 0xf8, 0xac, 0x00, 0xaf, 0xfe,
+	0x20, 0xf8, 0x65, 0xdf, 0x2c, 0x1c, 0xec, 0x7f, 0xfc, 0x11, 0x8d, 0xce, 0x04, 0xc4, 0x75, 0xdf,
+	0xbc, 0x23, 0xc9, 0xd2, 0x40, 0x16, 0x2a, 0xb8, 0x98, 0x0e, 0xcc, 0xe0, 0x33, 0x60, 0x0c, 0x0c,
+	0x58, 0xb7, 0xd3, 0xa1, 0x51, 0x5c, 0xce, 0x6e, 0x64, 0x36, 0xf5, 0xea, 0xcf, 0x2f, 0xfb, 0x66,
+	0x51, 0x41, 0x36, 0x64, 0xcf, 0x75, 0xdf, 0xbc, 0x3b, 0x06, 0xaa, 0x7c, 0x2c, 0x54, 0x54, 0xb0,
+	0xca, 0x14, 0x36, 0x41, 0x81, 0x78, 0x9d, 0x9d, 0xbd, 0x07, 0x6a, 0x00, 0x9a, 0x18, 0xc0, 0x6f,
+	0xa6, 0x0d, 0x20, 0x5f, 0x3f, 0x3c, 0xd9, 0xd9, 0x7b, 0x90, 0xc4, 0xbf, 0x22, 0xa9, 0x46, 0x51,
+	0x2c, 0x94, 0x97, 0xa2, 0x0c, 0x3e, 0xe1, 0xd8, 0x53, 0x1c, 0xf3, 0xb7, 0xe5, 0xd8, 0xbb, 0x89,
+	0x63, 0x2f, 0xcd, 0xb1, 0x97, 0xe6, 0x78, 0xa8, 0x38, 0x16, 0x6e, 0xcb, 0xf1, 0xf0, 0x26, 0x8e,
+	0x87, 0x69, 0x0e, 0x69, 0xc3, 0x93, 0xa9, 0x79, 0xf1, 0x67, 0x1c, 0xc6, 0x5e, 0x37, 0x50, 0x34,
+	0xfa, 0xad, 0x93, 0x69, 0xcc, 0xd3, 0x42, 0xc5, 0x81, 0x46, 0xa2, 0x9f, 0x81, 0x92, 0x43, 0x43,
+	0x16, 0x73, 0x5d, 0x48, 0x3b, 0x3e, 0x51, 0x14, 0x39, 0x41, 0xf1, 0x70, 0x1a, 0xc5, 0x9a, 0xa4,
+	0xb8, 0xc9, 0xdd, 0x42, 0x2b, 0x69, 0xb5, 0x24, 0xb3, 0x81, 0xd1, 0x21, 0x31, 0x89, 0x58, 0xb3,
+	0x1b, 0xb5, 0x14, 0x11, 0x10, 0x44, 0xef, 0x4f, 0x23, 0x52, 0x69, 0x35, 0xee, 0x6a, 0xa1, 0xa5,
+	0xa1, 0x4a, 0x12, 0x7c, 0x02, 0x8a, 0x1e, 0x67, 0x6d, 0x76, 0x7d, 0x05, 0x9f, 0x17, 0xf0, 0xbb,
+	0xd3, 0xe0, 0xd5, 0xa7, 0x90, 0x76, 0xb4, 0xd0, 0x62, 0xa2, 0x90, 0xd0, 0x2e, 0x80, 0x41, 0xd7,
+	0x8b, 0xec, 0x96, 0x8f, 0x1d, 0x8f, 0x44, 0x0a, 0xbe, 0x20, 0xe0, 0x7f, 0x31, 0x0d, 0xfe, 0x9e,
+	0x84, 0x9f, 0x74, 0xb6, 0x90, 0xc1, 0x95, 0xbf, 0x95, 0x3a, 0xc9, 0xd2, 0x00, 0x85, 0x26, 0x89,
+	0x7c, 0x2f, 0x54, 0xf8, 0x8b, 0x02, 0xff, 0xc1, 0x34, 0x7c, 0x95, 0x41, 0xa3, 0x6e, 0x16, 0xca,
+	0x4b, 0x71, 0x00, 0xea, 0xd3, 0xd0, 0xa5, 0x09, 0xe8, 0xf2, 0xad, 0x41, 0x47, 0xdd, 0x2c, 0x94,
+	0x97, 0xa2, 0x04, 0x6d, 0x81, 0x15, 0x1c, 0x45, 0xf4, 0xc5, 0xd8, 0x84, 0x40, 0x81, 0xfd, 0xcb,
+	0x69, 0xd8, 0xc9, 0xe6, 0x3a, 0xe9, 0xcd, 0x37, 0x57, 0xae, 0x4d, 0x4d, 0x89, 0x0b, 0x60, 0x2b,
+	0xc2, 0x17, 0x63, 0x3c, 0xa5, 0x5b, 0x4f, 0xfc, 0xa4, 0xb3, 0x85, 0x0c, 0xae, 0x4c, 0xb1, 0x7c,
+	0x06, 0x4a, 0x01, 0x89, 0x5a, 0xc4, 0x0e, 0x49, 0xcc, 0x3a, 0xbe, 0x17, 0x2b, 0x9e, 0x3b, 0xb7,
+	0xfe, 0x0e, 0x6e, 0x72, 0xb7, 0x10, 0x14, 0xea, 0x27, 0x4a, 0x2b, 0xb9, 0xee, 0x01, 0xdd, 0xe1,
+	0xa7, 0x85, 0xed, 0xb9, 0xe5, 0xb2, 0x28, 0x4d, 0x16, 0x84, 0x7c, 0xe8, 0xc2, 0x12, 0x98, 0x93,
+	0x67, 0xfb, 0x3d, 0x71, 0xb6, 0x4b, 0x01, 0x56, 0x80, 0xee, 0x12, 0xc7, 0x0b, 0xb0, 0xcf, 0xca,
+	0x15, 0xe1, 0x30, 0x90, 0xe1, 0x53, 0xb0, 0xc8, 0xda, 0x38, 0x6c, 0xb5, 0xb1, 0x67, 0xc7, 0x5e,
+	0x40, 0xca, 0x6b, 0x22, 0xe2, 0x9d, 0x69, 0x11, 0x97, 0x64, 0xc4, 0x29, 0x3f, 0x0b, 0x15, 0x12,
+	0xf9, 0xd4, 0x0b, 0x08, 0x3c, 0x01, 0x79, 0x07, 0x87, 0x4e, 0x37, 0x94, 0xa8, 0xf7, 0x05, 0xea,
+	0xf6, 0x34, 0x54, 0x75, 0x14, 0x8f, 0x78, 0x59, 0x08, 0x48, 0x29, 0x41, 0xec, 0x44, 0xb8, 0xd5,
+	0x25, 0x12, 0xf1, 0x8d, 0x5b, 0x23, 0x8e, 0x78, 0x59, 0x08, 0x48, 0x29, 0x41, 0xec, 0x91, 0xe8,
+	0xcc, 0x57, 0x88, 0xeb, 0xb7, 0x46, 0x1c, 0xf1, 0xb2, 0x10, 0x90, 0x92, 0x40, 0x3c, 0x06, 0x80,
+	0x32, 0x7c, 0x86, 0x25, 0xa0, 0x29, 0x00, 0xb7, 0xa6, 0x01, 0xaa, 0xfa, 0x7a, 0xe8, 0x64, 0xa1,
+	0x9c, 0x10, 0x38, 0xdc, 0xa0, 0xae, 0x5b, 0x35, 0xee, 0x1e, 0x69, 0xfa, 0x5d, 0xa3, 0x6c, 0x6d,
+	0x83, 0x39, 0x5e, 0xb7, 0x12, 0x68, 0x80, 0xec, 0x19, 0xb9, 0x50, 0x35, 0x1c, 0x6f, 0xf2, 0xb5,
+	0xef, 0x61, 0xbf, 0x4b, 0xe4, 0x71, 0x8e, 0xa4, 0x60, 0x9d, 0x80, 0xa5, 0xd3, 0x08, 0x87, 0x8c,
+	0xd7, 0xbc, 0x34, 0x7c, 0x4c, 0x5b, 0x0c, 0x42, 0xa0, 0xb5, 0x31, 0x6b, 0x2b, 0x5f, 0xd1, 0x86,
+	0x6f, 0x03, 0xcd, 0xa7, 0x2d, 0x26, 0x0a, 0x9b, 0xfc, 0xee, 0x9d, 0xc9, 0x2a, 0xea, 0x31, 0x6d,
+	0x21, 0x61, 0x62, 0xfd, 0x25, 0x0b, 0xb2, 0x8f, 0x69, 0x0b, 0x96, 0xc1, 0x02, 0x76, 0xdd, 0x88,
+	0x30, 0xa6, 0x90, 0x12, 0x11, 0xae, 0x82, 0xf9, 0x98, 0x76, 0x3c, 0x47, 0xc2, 0xe5, 0x90, 0x92,
+	0x38, 0xb1, 0x8b, 0x63, 0x2c, 0x6a, 0x80, 0x02, 0x12, 0x6d, 0x7e, 0x85, 0x10, 0xa9, 0x6e, 0x87,
+	0xdd, 0xa0, 0x49, 0x22, 0x71, 0x94, 0x6b, 0xd5, 0xa5, 0xab, 0xbe, 0x99, 0x17, 0xfa, 0x27, 0x42,
+	0x8d, 0x46, 0x05, 0xf8, 0x2e, 0x58, 0x88, 0xcf, 0x6d, 0x31, 0x86, 0x39, 0x31, 0xc5, 0x2b, 0x57,
+	0x7d, 0x73, 0x29, 0x1e, 0x0e, 0xf3, 0x77, 0x98, 0xb5, 0xd1, 0x7c, 0x7c, 0xce, 0xff, 0xc3, 0x6d,
+	0xa0, 0xc7, 0xe7, 0xb6, 0x17, 0xba, 0xe4, 0x5c, 0x1c, 0xe2, 0x5a, 0xb5, 0x74, 0xd5, 0x37, 0x8d,
+	0x11, 0xf3, 0x43, 0xde, 0x87, 0x16, 0xe2, 0x73, 0xd1, 0x80, 0xef, 0x02, 0x20, 0x43, 0x12, 0x0c,
+	0xf2, 0x4c, 0x5e, 0xbc, 0xea, 0x9b, 0x39, 0xa1, 0x15, 0xd8, 0xc3, 0x26, 0xb4, 0xc0, 0x9c, 0xc4,
+	0xd6, 0x05, 0x76, 0xe1, 0xaa, 0x6f, 0xea, 0x3e, 0x6d, 0x49, 0x4c, 0xd9, 0xc5, 0xa7, 0x2a, 0x22,
+	0x01, 0xed, 0x11, 0x57, 0x1c, 0x8c, 0x3a, 0x4a, 0x44, 0xf8, 0x01, 0x58, 0x92, 0x5c, 0x7c, 0xed,
+	0x59, 0x8c, 0x83, 0x8e, 0xbc, 0x6d, 0x54, 0xe1, 0x55, 0xdf, 0x2c, 0x8a, 0xae, 0xd3, 0xa4, 0x07,
+	0x8d, 0xc9, 0xd6, 0x17, 0xb3, 0x40, 0x3f, 0x3d, 0x47, 0x84, 0x75, 0xfd, 0x18, 0x7e, 0x04, 0x0c,
+	0x51, 0x68, 0x62, 0x27, 0xb6, 0x53, 0xeb, 0x52, 0x5d, 0x1b, 0x9e, 0x81, 0xe3, 0x16, 0x16, 0x5a,
+	0x4a, 0x54, 0xfb, 0x6a, 0xf1, 0x4a, 0x60, 0xae, 0xe9, 0x53, 0x1a, 0x88, 0x34, 0x2a, 0x20, 0x29,
+	0xc0, 0x67, 0x62, 0xca, 0x45, 0x8a, 0x64, 0x45, 0x11, 0xff, 0xa3, 0xc9, 0x14, 0x19, 0xcb, 0xb3,
+	0xea, 0x1a, 0x2f, 0xe1, 0xaf, 0xfb, 0x66, 0x51, 0x72, 0x2b, 0x7f, 0xeb, 0xeb, 0xef, 0xbf, 0x79,
+	0x27, 0xc3, 0x57, 0x47, 0x24, 0xa3, 0x01, 0xb2, 0x11, 0x89, 0xc5, 0xb2, 0x17, 0x10, 0x6f, 0xf2,
+	0xdd, 0x2a, 0x22, 0x3d, 0x12, 0xc5, 0xc4, 0x15, 0xcb, 0xab, 0xa3, 0x81, 0xcc, 0xb7, 0xbe, 0x16,
+	0x66, 0x76, 0x97, 0x11, 0x57, 0xae, 0x25, 0x5a, 0x68, 0x61, 0xf6, 0x07, 0x46, 0xdc, 0x47, 0xda,
+	0xe7, 0x5f, 0x9a, 0x33, 0x16, 0x06, 0x79, 0x55, 0xdf, 0x77, 0x3b, 0x3e, 0x99, 0x92, 0xa3, 0xbb,
+	0xa0, 0xc0, 0x6f, 0x73, 0xb8, 0x45, 0xec, 0x33, 0x72, 0xa1, 0x32, 0x55, 0xe6, 0x9d, 0xd2, 0xff,
+	0x9e, 0x5c, 0x30, 0x34, 0x2a, 0x28, 0x8a, 0x2f, 0x35, 0x90, 0x3f, 0x8d, 0xb0, 0x43, 0x54, 0xb5,
+	0xce, 0xb3, 0x9d, 0x8b, 0x91, 0xa2, 0x50, 0x12, 0xe7, 0xe6, 0x8b, 0x4a, 0xbb, 0xb1, 0xfa, 0x22,
+	0x13, 0x91, 0x7b, 0x44, 0x84, 0x9c, 0x13, 0x47, 0xcc, 0xa5, 0x86, 0x94, 0x04, 0xf7, 0xc0, 0xa2,
+	0xeb, 0x31, 0xdc, 0xf4, 0xc5, 0xe5, 0xd5, 0x39, 0x93, 0xc3, 0xaf, 0x1a, 0x57, 0x7d, 0xb3, 0xa0,
+	0x3a, 0x1a, 0x5c, 0x8f, 0x52, 0x12, 0xcf, 0xa1, 0xa1, 0x9b, 0x88, 0x56, 0xcc, 0x8d, 0x2e, 0x73,
+	0x68, 0x60, 0x2a, 0x7a, 0xd0, 0x98, 0x2c, 0x4f, 0x8c, 0x66, 0xb7, 0x25, 0xd2, 0x57, 0x47, 0x52,
+	0xe0, 0x5a, 0xdf, 0x0b, 0xbc, 0x58, 0xa4, 0xeb, 0x1c, 0x92, 0x02, 0xfc, 0x00, 0xe4, 0x68, 0x8f,
+	0x44, 0x91, 0xe7, 0x12, 0x26, 0xd2, 0x34, 0xbf, 0xfb, 0xc6, 0x64, 0x1a, 0x8c, 0xdc, 0x64, 0xd0,
+	0xd0, 0x9e, 0x0f, 0x8e, 0x84, 0x22, 0xc8, 0x80, 0x04, 0x34, 0xba, 0x28, 0xe7, 0x87, 0x83, 0x93,
+	0x1d, 0xc7, 0x42, 0x8f, 0x52, 0x12, 0xac, 0x02, 0xa8, 0xdc, 0x22, 0x12, 0x77, 0xa3, 0xd0, 0x16,
+	0x3b, 0x48, 0x41, 0xf8, 0x8a, 0xef, 0x58, 0xf6, 0x22, 0xd1, 0x79, 0x80, 0x63, 0x8c, 0x26, 0x34,
+	0xf0, 0xd7, 0x00, 0xca, 0x35, 0xb1, 0x3f, 0x63, 0x34, 0xe4, 0xf7, 0xb1, 0xe7, 0x5e, 0x4b, 0xd5,
+	0x46, 0x82, 0x5f, 0xf6, 0xaa, 0x98, 0x0d, 0x29, 0x1d, 0x31, 0xaa, 0x46, 0x71, 0xa4, 0xe9, 0x9a,
+	0x31, 0x77, 0xa4, 0xe9, 0x0b, 0x86, 0x3e, 0x98, 0x3f, 0x35, 0x0a, 0xb4, 0x92, 0xc8, 0x23, 0xe1,
+	0x59, 0xaf, 0x32, 0x00, 0x9c, 0x44, 0xc4, 0xe3, 0x25, 0xac, 0xef, 0xf3, 0x7d, 0x2f, 0xc4, 0x01,
+	0x49, 0x36, 0x5c, 0xde, 0x1e, 0xcd, 0xcc, 0xd9, 0x74, 0x66, 0x42, 0xa0, 0x39, 0xd4, 0x25, 0x22,
+	0x37, 0x72, 0x48, 0xb4, 0xb9, 0x75, 0x8f, 0x44, 0xcc, 0xa3, 0xa1, 0xbc, 0xeb, 0xa0, 0x44, 0xe4,
+	0xb9, 0xc4, 0x68, 0x37, 0x72, 0x88, 0xdc, 0x0a, 0x91, 0x92, 0xe0, 0x1a, 0xc8, 0x71, 0x4f, 0xb9,
+	0x87, 0x89, 0xbb, 0x0b, 0xd2, 0xb9, 0x82, 0xef, 0x59, 0xd6, 0x31, 0xc8, 0xd7, 0x92, 0xcf, 0xbe,
+	0x7a, 0x38, 0xe5, 0x2b, 0x49, 0x22, 0x9f, 0x1d, 0x89, 0xdc, 0x00, 0x59, 0xdc, 0xf4, 0x54, 0x78,
+	0xbc, 0x69, 0x3d, 0x01, 0x4b, 0xf5, 0xa7, 0xc7, 0xe2, 0x82, 0xe6, 0xb4, 0x89, 0xdb, 0xf5, 0x09,
+	0x77, 0xe4, 0x57, 0xb8, 0x64, 0xc8, 0xbc, 0x0d, 0x7f, 0x0a, 0x96, 0xc4, 0xcb, 0x0b, 0xe6, 0x3b,
+	0x84, 0x3c, 0x21, 0x67, 0x45, 0xfe, 0x17, 0x87, 0x6a, 0xbe, 0xb9, 0x59, 0x5f, 0xcd, 0x82, 0x42,
+	0x8d, 0xba, 0xe4, 0x98, 0xc4, 0x58, 0x1c, 0x12, 0xa9, 0xc1, 0x64, 0xd2, 0x83, 0x81, 0x6f, 0x03,
+	0x43, 0xbd, 0xe0, 0x44, 0x76, 0x32, 0x49, 0x32, 0xde, 0xa5, 0x44, 0xff, 0x54, 0x4d, 0xd6, 0x44,
+	0xe8, 0xd0, 0x04, 0x79, 0x39, 0x61, 0x12, 0x5b, 0x4e, 0x2e, 0x90, 0x2a, 0x81, 0x7e, 0x1f, 0xe4,
+	0x58, 0xb7, 0x19, 0x78, 0x71, 0x4c, 0x22, 0x79, 0x16, 0xa0, 0xa1, 0x82, 0xcf, 0x7e, 0x9b, 0x78,
+	0xad, 0x76, 0x2c, 0x3e, 0x9f, 0x2c, 0x52, 0x92, 0x8c, 0x69, 0x6c, 0x33, 0xce, 0x25, 0x31, 0xa5,
+	0xf7, 0xdb, 0x9f, 0x81, 0x65, 0xf1, 0x4a, 0xc2, 0xe7, 0xa4, 0x47, 0x22, 0xef, 0xb9, 0x47, 0x5c,
+	0xf1, 0x71, 0xe9, 0xc8, 0x48, 0x3a, 0x9e, 0x2a, 0x7d, 0xea, 0xa1, 0xe7, 0x55, 0x06, 0x18, 0xc9,
+	0x2a, 0xd6, 0x94, 0xe1, 0x94, 0xa5, 0x2c, 0x83, 0x05, 0x01, 0x47, 0xa3, 0x24, 0xe1, 0x94, 0x08,
+	0xef, 0x0e, 0x8f, 0xd3, 0xac, 0xda, 0xc1, 0xe4, 0xc9, 0x39, 0x1c, 0x9d, 0x96, 0x1a, 0xdd, 0x5b,
+	0xa0, 0xe8, 0x85, 0x5e, 0x6c, 0x0f, 0xd7, 0x44, 0xe6, 0x5e, 0x81, 0x6b, 0x6b, 0x49, 0x92, 0xfd,
+	0x35, 0x03, 0xf2, 0xf5, 0x5e, 0x50, 0xa3, 0x5e, 0x78, 0x18, 0x3e, 0xa7, 0xc3, 0xda, 0x34, 0x33,
+	0x5a, 0x9b, 0x4e, 0x3e, 0x4b, 0xcd, 0xde, 0xf0, 0x2c, 0x05, 0xdf, 0x14, 0x5b, 0x63, 0xc7, 0xc7,
+	0x17, 0xca, 0x4a, 0x46, 0x5a, 0x50, 0xca, 0x83, 0x89, 0x3a, 0x97, 0x47, 0xbc, 0x38, 0xac, 0x73,
+	0xdf, 0xf9, 0x47, 0x06, 0x8c, 0xbc, 0xfc, 0xc0, 0x5f, 0x81, 0xca, 0x7e, 0xad, 0x56, 0x6f, 0x34,
+	0xec, 0xd3, 0x4f, 0x4e, 0xea, 0xf6, 0x49, 0x1d, 0x1d, 0x1f, 0x36, 0x1a, 0x87, 0x1f, 0x3f, 0x79,
+	0x5c, 0x6f, 0x34, 0x8c, 0x99, 0xca, 0xfd, 0x97, 0xaf, 0x36, 0xca, 0x43, 0xfb, 0x13, 0x12, 0x05,
+	0x1e, 0xe3, 0x39, 0xe4, 0xf3, 0xb9, 0x7c, 0x1f, 0xac, 0x8e, 0x7a, 0xa3, 0x7a, 0xe3, 0x14, 0x1d,
+	0xd6, 0x4e, 0xeb, 0x07, 0x46, 0xa6, 0x52, 0x7e, 0xf9, 0x6a, 0xa3, 0x34, 0xf4, 0x44, 0x84, 0xc5,
+	0x91, 0xe7, 0xf0, 0xc3, 0xeb, 0x21, 0x28, 0xdf, 0xcc, 0x59, 0x3f, 0x30, 0x66, 0x2b, 0x95, 0x97,
+	0xaf, 0x36, 0x56, 0x6f, 0x62, 0x24, 0x6e, 0x45, 0xfb, 0xfc, 0xab, 0xf5, 0x99, 0xea, 0xa3, 0x6f,
+	0x2f, 0xd7, 0x33, 0xdf, 0x5d, 0xae, 0x67, 0xfe, 0x73, 0xb9, 0x9e, 0xf9, 0xe2, 0xf5, 0xfa, 0xcc,
+	0x77, 0xaf, 0xd7, 0x67, 0xfe, 0xf9, 0x7a, 0x7d, 0xe6, 0x8f, 0x1b, 0x2d, 0x2f, 0x6e, 0x77, 0x9b,
+	0x5b, 0x0e, 0x0d, 0xb6, 0xc7, 0x9f, 0x0b, 0xf9, 0x9b, 0x16, 0x6b, 0xce, 0x8b, 0x17, 0xe3, 0xf7,
+	0xfe, 0x3b, 0x00, 0xde, 0xe9, 0xfb, 0x01, 0x8a, 0x16, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -2139,6 +2512,27 @@ func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Code) > 0 {
 		i -= len(m.Code)
 		copy(dAtA[i:], m.Code)
@@ -2163,7 +2557,7 @@ func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EvmCoinInfo) Marshal() (dAtA []byte, err error) {
+func (m *ContractABI) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2173,64 +2567,279 @@ func (m *EvmCoinInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EvmCoinInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractABI) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EvmCoinInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Decimals != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.DisplayDenom) > 0 {
-		i -= len(m.DisplayDenom)
-		copy(dAtA[i:], m.DisplayDenom)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.DisplayDenom)))
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Abi)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ExtendedDenom) > 0 {
-		i -= len(m.ExtendedDenom)
-		copy(dAtA[i:], m.ExtendedDenom)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.ExtendedDenom)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EVMForkSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EVMForkSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMForkSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
+	if m.ActivationTime != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fork) > 0 {
+		i -= len(m.Fork)
+		copy(dAtA[i:], m.Fork)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Fork)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationVerified {
+		i--
+		if m.CreationVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCreation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCreation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCreation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitCodeHash) > 0 {
+		i -= len(m.InitCodeHash)
+		copy(dAtA[i:], m.InitCodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.InitCodeHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmCoinInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmCoinInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmCoinInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExtendedDenom) > 0 {
+		i -= len(m.ExtendedDenom)
+		copy(dAtA[i:], m.ExtendedDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ExtendedDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
 	if len(m.ExtraEIPs) > 0 {
@@ -2604,41 +3213,157 @@ func (m *Preinstall) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
-func (m *EvmCoinInfo) Size() (n int) {
+func (m *ContractABI) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	l = len(m.ExtendedDenom)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	l = len(m.DisplayDenom)
+	l = len(m.Abi)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovEvm(uint64(m.Decimals))
+	return n
+}
+
+func (m *EVMForkSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fork)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovEvm(uint64(m.ActivationTime))
 	}
 	return n
 }
 
-func sovEvm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.CreationVerified {
+		n += 2
+	}
+	return n
 }
-func sozEvm(x uint64) (n int) {
-	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ContractCreation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	l = len(m.InitCodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *EvmCoinInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovEvm(uint64(m.Decimals))
+	}
+	return n
+}
+
+func sovEvm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvm(x uint64) (n int) {
+	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -4866,7 +5591,829 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessTuple) Unmarshal(dAtA []byte) error {
+func (m *AccessTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageKeys = append(m.StorageKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+			}
+			m.Reexec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reexec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableStack = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableStorage = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Overrides == nil {
+				m.Overrides = &ChainConfig{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableMemory = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableReturnData = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Preinstall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractABI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractABI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractABI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMForkSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4889,15 +6436,15 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: EVMForkSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EVMForkSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4925,13 +6472,13 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Fork = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
 			}
-			var stringLen uint64
+			m.ActivationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -4941,24 +6488,11 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActivationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageKeys = append(m.StorageKeys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -4980,7 +6514,7 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TraceConfig) Unmarshal(dAtA []byte) error {
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5003,15 +6537,15 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5039,11 +6573,11 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tracer = string(dAtA[iNdEx:postIndex])
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5071,13 +6605,13 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
 			}
-			m.Reexec = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -5087,56 +6621,29 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reexec |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
 			}
-			m.DisableStack = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DisableStorage = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -5146,36 +6653,29 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Debug = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
 			}
-		case 10:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -5185,53 +6685,29 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Overrides == nil {
-				m.Overrides = &ChainConfig{}
-			}
-			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableMemory = bool(v != 0)
-		case 12:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -5241,15 +6717,14 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableReturnData = bool(v != 0)
-		case 13:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5277,8 +6752,28 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreationVerified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -5300,7 +6795,7 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Preinstall) Unmarshal(dAtA []byte) error {
+func (m *ContractCreation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,15 +6818,15 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCreation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCreation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5359,11 +6854,11 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5391,11 +6886,11 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5423,7 +6918,58 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/params/forks"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewEVMForkSchedule creates a new EVMForkSchedule instance.
+func NewEVMForkSchedule(fork string, activationTime uint64) EVMForkSchedule {
+	return EVMForkSchedule{
+		Fork:           strings.ToLower(fork),
+		ActivationTime: activationTime,
+	}
+}
+
+// Validate performs a stateless validation of the fork schedule. The fork must
+// be a timestamp based hard fork known by the compiled go-ethereum version.
+func (s EVMForkSchedule) Validate() error {
+	if err := s.ValidateFork(); err != nil {
+		return err
+	}
+
+	if s.ActivationTime == 0 {
+		return errorsmod.Wrapf(ErrInvalidForkSchedule, "activation time of fork %s cannot be zero", s.Fork)
+	}
+
+	return nil
+}
+
+// ValidateFork checks that the fork of the schedule is a timestamp based hard
+// fork known by the compiled go-ethereum version.
+func (s EVMForkSchedule) ValidateFork() error {
+	_, err := parseTimestampFork(s.Fork)
+	return err
+}
+
+// WithForkSchedule returns a copy of the chain config with the activation time
+// of the scheduled fork set. The resulting config is validated against the
+// fork order rules of the compiled go-ethereum version.
+func (cc ChainConfig) WithForkSchedule(s EVMForkSchedule) (*ChainConfig, error) {
+	fork, err := parseTimestampFork(s.Fork)
+	if err != nil {
+		return nil, err
+	}
+
+	activationTime := sdkmath.NewIntFromUint64(s.ActivationTime)
+	switch fork {
+	case forks.Shanghai:
+		cc.ShanghaiTime = &activationTime
+	case forks.Cancun:
+		cc.CancunTime = &activationTime
+	case forks.Prague:
+		cc.PragueTime = &activationTime
+	case forks.Osaka:
+		cc.OsakaTime = &activationTime
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidForkSchedule, "fork %s has no chain config activation time", s.Fork)
+	}
+
+	if err := cc.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidForkSchedule, "fork %s: %s", s.Fork, err)
+	}
+
+	// make sure go-ethereum resolves the scheduled time to the expected fork
+	ethCfg := cc.EthereumConfig(nil)
+	if ts := ethCfg.Timestamp(fork); ts == nil || *ts != s.ActivationTime {
+		return nil, errorsmod.Wrapf(ErrInvalidForkSchedule, "fork %s is not supported by the go-ethereum version", s.Fork)
+	}
+
+	return &cc, nil
+}
+
+// ForkActivationTime returns the activation time of the given fork in the chain
+// config and whether it is set.
+func (cc ChainConfig) ForkActivationTime(name string) (uint64, bool) {
+	fork, err := parseTimestampFork(name)
+	if err != nil {
+		return 0, false
+	}
+
+	ts := cc.EthereumConfig(nil).Timestamp(fork)
+	if ts == nil {
+		return 0, false
+	}
+	return *ts, true
+}
+
+// ApplyForkSchedules sets the activation times of the scheduled forks on the
+// chain config set by the EVMConfigurator, and replaces the global chain config
+// with the result. The forks which are no longer scheduled, i.e. cancelled,
+// get back the activation time of the configured chain config. The global
+// chain config is only replaced when it differs from the result.
+func ApplyForkSchedules(schedules []EVMForkSchedule) error {
+	base := getBaseChainConfig()
+	if base == nil {
+		return nil
+	}
+
+	updated := base
+	for _, schedule := range schedules {
+		if ts, ok := updated.ForkActivationTime(schedule.Fork); ok && ts == schedule.ActivationTime {
+			continue
+		}
+
+		cfg, err := updated.WithForkSchedule(schedule)
+		if err != nil {
+			return err
+		}
+		updated = cfg
+	}
+
+	if current := GetChainConfig(); current == nil || !current.Equal(updated) {
+		replaceChainConfig(updated)
+	}
+	return nil
+}
+
+// Equal reports whether both chain configs have the same values.
+func (cc *ChainConfig) Equal(other *ChainConfig) bool {
+	if cc == other {
+		return true
+	}
+	if cc == nil || other == nil {
+		return false
+	}
+	bz, err := cc.Marshal()
+	if err != nil {
+		return false
+	}
+	otherBz, err := other.Marshal()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(bz, otherBz)
+}
+
+// parseTimestampFork returns the go-ethereum identifier of the timestamp based
+// fork with the given lowercase name.
+func parseTimestampFork(name string) (forks.Fork, error) {
+	supported := make([]string, 0)
+	for fork := forks.Shanghai; fork.String() != forks.Fork(-1).String(); fork++ {
+		forkName := strings.ToLower(fork.String())
+		if name == forkName {
+			return fork, nil
+		}
+		supported = append(supported, forkName)
+	}
+
+	return 0, errorsmod.Wrapf(
+		ErrInvalidForkSchedule,
+		"unknown fork %q, supported forks are: %s", name, strings.Join(supported, ", "),
+	)
+}
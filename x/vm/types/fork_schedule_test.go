@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEVMForkSchedule_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule EVMForkSchedule
+		errorMsg string
+	}{
+		{
+			name:     "valid osaka schedule",
+			schedule: NewEVMForkSchedule("Osaka", 1_800_000_000),
+		},
+		{
+			name:     "unknown fork",
+			schedule: NewEVMForkSchedule("verkle", 1_800_000_000),
+			errorMsg: "unknown fork",
+		},
+		{
+			name:     "block based fork",
+			schedule: NewEVMForkSchedule("london", 1_800_000_000),
+			errorMsg: "unknown fork",
+		},
+		{
+			name:     "zero activation time",
+			schedule: NewEVMForkSchedule("osaka", 0),
+			errorMsg: "cannot be zero",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestChainConfig_WithForkSchedule(t *testing.T) {
+	cfg := DefaultChainConfig(0)
+
+	_, ok := cfg.ForkActivationTime("osaka")
+	require.False(t, ok)
+
+	updated, err := cfg.WithForkSchedule(NewEVMForkSchedule("osaka", 1_800_000_000))
+	require.NoError(t, err)
+	require.Nil(t, cfg.OsakaTime, "original config must not be modified")
+
+	ts, ok := updated.ForkActivationTime("osaka")
+	require.True(t, ok)
+	require.Equal(t, uint64(1_800_000_000), ts)
+	require.True(t, updated.EthereumConfig(nil).IsOsaka(updated.EthereumConfig(nil).LondonBlock, ts))
+
+	// prague cannot be activated after osaka
+	_, err = updated.WithForkSchedule(NewEVMForkSchedule("prague", 1_900_000_000))
+	require.ErrorContains(t, err, "invalid config fork order")
+}
+
+func TestMsgScheduleEVMFork_ValidateBasic(t *testing.T) {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+	// a zero activation time cancels the schedule of a known fork
+	require.NoError(t, (&MsgScheduleEVMFork{Authority: authority, Fork: "osaka"}).ValidateBasic())
+	require.ErrorContains(t, (&MsgScheduleEVMFork{Authority: authority, Fork: "verkle"}).ValidateBasic(), "unknown fork")
+	require.NoError(t, (&MsgScheduleEVMFork{Authority: authority, Fork: "osaka", ActivationTime: 1_800_000_000}).ValidateBasic())
+	require.Error(t, (&MsgScheduleEVMFork{Authority: "invalid", Fork: "osaka"}).ValidateBasic())
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/utils"
 )
 
@@ -18,9 +20,14 @@ func (ga GenesisAccount) Validate() error {
 // chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts:    []GenesisAccount{},
-		Params:      DefaultParams(),
-		Preinstalls: []Preinstall{},
+		Accounts:             []GenesisAccount{},
+		Params:               DefaultParams(),
+		Preinstalls:          []Preinstall{},
+		ContractABIs:         []ContractABI{},
+		ForkSchedules:        []EVMForkSchedule{},
+		CodeMetadata:         []CodeMetadata{},
+		ContractCreations:    []ContractCreation{},
+		InstalledPreinstalls: []Preinstall{},
 	}
 }
 
@@ -66,5 +73,75 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	seenContractABIs := make(map[common.Address]bool)
+	for _, contractABI := range gs.ContractABIs {
+		if err := contractABI.Validate(); err != nil {
+			return fmt.Errorf("invalid contract abi %s: %w", contractABI.Address, err)
+		}
+
+		address := common.HexToAddress(contractABI.Address)
+		if seenContractABIs[address] {
+			return fmt.Errorf("duplicated contract abi address %s", contractABI.Address)
+		}
+		seenContractABIs[address] = true
+	}
+
+	seenForkSchedules := make(map[string]bool)
+	for _, schedule := range gs.ForkSchedules {
+		if seenForkSchedules[schedule.Fork] {
+			return fmt.Errorf("duplicated fork schedule %s", schedule.Fork)
+		}
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("invalid fork schedule %s: %w", schedule.Fork, err)
+		}
+		seenForkSchedules[schedule.Fork] = true
+	}
+
+	seenCodeMetadata := make(map[common.Hash]bool)
+	for _, metadata := range gs.CodeMetadata {
+		if err := metadata.Validate(); err != nil {
+			return fmt.Errorf("invalid code metadata %s: %w", metadata.CodeHash, err)
+		}
+
+		codeHash := common.HexToHash(metadata.CodeHash)
+		if seenCodeMetadata[codeHash] {
+			return fmt.Errorf("duplicated code metadata %s", metadata.CodeHash)
+		}
+		seenCodeMetadata[codeHash] = true
+	}
+
+	seenContractCreations := make(map[common.Address]bool)
+	for _, creation := range gs.ContractCreations {
+		if err := creation.Validate(); err != nil {
+			return fmt.Errorf("invalid contract creation %s: %w", creation.Address, err)
+		}
+
+		address := common.HexToAddress(creation.Address)
+		if seenContractCreations[address] {
+			return fmt.Errorf("duplicated contract creation %s", creation.Address)
+		}
+		seenContractCreations[address] = true
+	}
+
+	// Validate the registry of the installed preinstalls. Their code is part of
+	// the genesis accounts, so they must not be deployed again as preinstalls.
+	seenInstalled := make(map[common.Address]bool)
+	for _, preinstall := range gs.InstalledPreinstalls {
+		if err := preinstall.ValidateRegistryEntry(); err != nil {
+			return fmt.Errorf("invalid installed preinstall %s: %w", preinstall.Address, err)
+		}
+
+		address := common.HexToAddress(preinstall.Address)
+		if seenInstalled[address] {
+			return fmt.Errorf("duplicated installed preinstall %s", preinstall.Address)
+		}
+		seenInstalled[address] = true
+	}
+	for _, preinstall := range gs.Preinstalls {
+		if seenInstalled[common.HexToAddress(preinstall.Address)] {
+			return fmt.Errorf("preinstall %s is already installed", preinstall.Address)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -32,6 +32,19 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// contract_abis defines the contracts whose logs are emitted as typed Cosmos
+	// events
+	ContractABIs []ContractABI `protobuf:"bytes,4,rep,name=contract_abis,json=contractAbis,proto3" json:"contract_abis"`
+	// fork_schedules defines the Ethereum hard forks scheduled through governance
+	ForkSchedules []EVMForkSchedule `protobuf:"bytes,5,rep,name=fork_schedules,json=forkSchedules,proto3" json:"fork_schedules"`
+	// code_metadata defines the verified metadata of the registered codes
+	CodeMetadata []CodeMetadata `protobuf:"bytes,6,rep,name=code_metadata,json=codeMetadata,proto3" json:"code_metadata"`
+	// contract_creations defines the deployment records of the contracts
+	ContractCreations []ContractCreation `protobuf:"bytes,7,rep,name=contract_creations,json=contractCreations,proto3" json:"contract_creations"`
+	// installed_preinstalls defines the registry of the already installed
+	// preinstalls. Contrary to preinstalls, the code of these contracts is part
+	// of the genesis accounts, so the entries only hold the code hash.
+	InstalledPreinstalls []Preinstall `protobuf:"bytes,8,rep,name=installed_preinstalls,json=installedPreinstalls,proto3" json:"installed_preinstalls"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +101,41 @@ func (m *GenesisState) GetPreinstalls() []Preinstall {
 	return nil
 }
 
+func (m *GenesisState) GetContractABIs() []ContractABI {
+	if m != nil {
+		return m.ContractABIs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForkSchedules() []EVMForkSchedule {
+	if m != nil {
+		return m.ForkSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeMetadata() []CodeMetadata {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractCreations() []ContractCreation {
+	if m != nil {
+		return m.ContractCreations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInstalledPreinstalls() []Preinstall {
+	if m != nil {
+		return m.InstalledPreinstalls
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -162,29 +210,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0xda, 0x26, 0xcd, 0x26, 0xa9, 0xe8, 0x2a, 0x08, 0x13, 0x81, 0x13, 0x72, 0x8a,
+	0x38, 0xd8, 0x6a, 0xb9, 0xc1, 0x29, 0xae, 0x20, 0xea, 0xa1, 0x08, 0x25, 0x12, 0x07, 0x54, 0x14,
+	0x6d, 0xd6, 0x5b, 0xd7, 0x6a, 0xec, 0x8d, 0x3c, 0x9b, 0x0a, 0x9e, 0x80, 0x2b, 0x8f, 0x81, 0x38,
+	0xf5, 0x31, 0x7a, 0xec, 0x91, 0x53, 0x41, 0xc9, 0x81, 0xd7, 0x40, 0xfb, 0x91, 0xb0, 0xd4, 0xe1,
+	0x80, 0x64, 0x45, 0x9b, 0x99, 0xff, 0xfc, 0x66, 0x76, 0x76, 0x06, 0x79, 0x94, 0x43, 0xca, 0x21,
+	0x60, 0x97, 0x69, 0x20, 0xbf, 0x83, 0x20, 0x66, 0x19, 0x83, 0x04, 0xfc, 0x59, 0xce, 0x05, 0xc7,
+	0xf7, 0xb5, 0xdf, 0x67, 0x97, 0xa9, 0x2f, 0xbf, 0x83, 0xd6, 0x3e, 0x49, 0x93, 0x8c, 0x07, 0xea,
+	0x57, 0x8b, 0x5a, 0xad, 0x02, 0x44, 0xca, 0xb5, 0xaf, 0x19, 0xf3, 0x98, 0xab, 0x63, 0x20, 0x4f,
+	0xda, 0xda, 0xbd, 0xda, 0x41, 0xf5, 0x81, 0x4e, 0x34, 0x12, 0x44, 0x30, 0x3c, 0x40, 0xbb, 0x84,
+	0x52, 0x3e, 0xcf, 0x04, 0xb8, 0x4e, 0x67, 0xab, 0x57, 0x3b, 0xec, 0xf8, 0x77, 0x53, 0xfb, 0x26,
+	0xa2, 0xaf, 0x85, 0x61, 0xf5, 0xfa, 0xb6, 0x5d, 0xfa, 0xfa, 0xeb, 0xea, 0x99, 0x33, 0x5c, 0x07,
+	0xe3, 0x97, 0xa8, 0x3c, 0x23, 0x39, 0x49, 0xc1, 0xbd, 0xd7, 0x71, 0x7a, 0xb5, 0x43, 0xb7, 0x88,
+	0x79, 0xab, 0xfc, 0x76, 0xb8, 0x09, 0xc1, 0xc7, 0xa8, 0x36, 0xcb, 0x59, 0x92, 0x81, 0x20, 0xd3,
+	0x29, 0xb8, 0x5b, 0xaa, 0x90, 0xc7, 0x1b, 0x08, 0x6b, 0x91, 0x4d, 0xb1, 0x63, 0xf1, 0x07, 0xd4,
+	0xa0, 0x3c, 0x13, 0x39, 0xa1, 0x62, 0x4c, 0x26, 0x09, 0xb8, 0xdb, 0x0a, 0xf6, 0xa4, 0x08, 0x3b,
+	0x32, 0xb2, 0x7e, 0x78, 0x1c, 0x3e, 0x92, 0xb4, 0xc5, 0x6d, 0xbb, 0x6e, 0x19, 0x41, 0xd3, 0xeb,
+	0x2b, 0x5c, 0x7f, 0x92, 0x00, 0x8e, 0xd0, 0xde, 0x19, 0xcf, 0x2f, 0xc6, 0x40, 0xcf, 0x59, 0x34,
+	0x9f, 0x32, 0x70, 0x77, 0x14, 0xff, 0x69, 0x91, 0xff, 0xea, 0xdd, 0xc9, 0x6b, 0x9e, 0x5f, 0x8c,
+	0x8c, 0x32, 0x6c, 0x99, 0x1c, 0x0d, 0xdb, 0x6a, 0x92, 0x34, 0xce, 0x6c, 0x1b, 0x7e, 0x23, 0x2f,
+	0x11, 0xb1, 0x71, 0xca, 0x04, 0x89, 0x88, 0x20, 0x6e, 0x59, 0x25, 0xf1, 0x36, 0x5d, 0x22, 0x62,
+	0x27, 0x46, 0x65, 0xf7, 0xa4, 0x4e, 0x2d, 0x07, 0x3e, 0x45, 0x78, 0xdd, 0x14, 0x9a, 0x33, 0x22,
+	0x12, 0x9e, 0x81, 0x5b, 0x51, 0xd0, 0xee, 0xbf, 0x3b, 0x73, 0x64, 0xa4, 0x36, 0x78, 0x9f, 0xde,
+	0x71, 0x02, 0x3e, 0x45, 0x0f, 0x4c, 0xfb, 0x59, 0x34, 0xb6, 0xdf, 0x71, 0xf7, 0xff, 0xde, 0xb1,
+	0xb9, 0xa6, 0xfc, 0xf1, 0x43, 0xf7, 0xb3, 0x83, 0xf6, 0xfe, 0x1e, 0x40, 0xec, 0xa2, 0x0a, 0x89,
+	0xa2, 0x9c, 0x81, 0x9c, 0x59, 0xa7, 0x57, 0x1d, 0xae, 0xfe, 0x62, 0x8c, 0xb6, 0xe5, 0xc5, 0xd5,
+	0x0c, 0x56, 0x87, 0xea, 0x8c, 0x07, 0xa8, 0x02, 0x82, 0xe7, 0x24, 0x66, 0x66, 0xb0, 0x1e, 0x16,
+	0x0b, 0x52, 0xcb, 0x10, 0x36, 0x65, 0x2d, 0xdf, 0x7e, 0xb4, 0x2b, 0x23, 0xad, 0xd7, 0x65, 0xad,
+	0xa2, 0xc3, 0x17, 0xd7, 0x0b, 0xcf, 0xb9, 0x59, 0x78, 0xce, 0xcf, 0x85, 0xe7, 0x7c, 0x59, 0x7a,
+	0xa5, 0x9b, 0xa5, 0x57, 0xfa, 0xbe, 0xf4, 0x4a, 0xef, 0x3b, 0x71, 0x22, 0xce, 0xe7, 0x13, 0x9f,
+	0xf2, 0x34, 0xb0, 0x76, 0xf2, 0xa3, 0xdc, 0x4a, 0xf1, 0x69, 0xc6, 0x60, 0x52, 0x56, 0xfb, 0xf7,
+	0xfc, 0xf7, 0x00, 0xda, 0xdb, 0xb2, 0x6b, 0xf8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +265,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InstalledPreinstalls) > 0 {
+		for iNdEx := len(m.InstalledPreinstalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstalledPreinstalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ContractCreations) > 0 {
+		for iNdEx := len(m.ContractCreations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCreations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CodeMetadata) > 0 {
+		for iNdEx := len(m.CodeMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ForkSchedules) > 0 {
+		for iNdEx := len(m.ForkSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForkSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractABIs) > 0 {
+		for iNdEx := len(m.ContractABIs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractABIs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +458,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractABIs) > 0 {
+		for _, e := range m.ContractABIs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForkSchedules) > 0 {
+		for _, e := range m.ForkSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeMetadata) > 0 {
+		for _, e := range m.CodeMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractCreations) > 0 {
+		for _, e := range m.ContractCreations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InstalledPreinstalls) > 0 {
+		for _, e := range m.InstalledPreinstalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -492,6 +650,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractABIs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractABIs = append(m.ContractABIs, ContractABI{})
+			if err := m.ContractABIs[len(m.ContractABIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkSchedules = append(m.ForkSchedules, EVMForkSchedule{})
+			if err := m.ForkSchedules[len(m.ForkSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata, CodeMetadata{})
+			if err := m.CodeMetadata[len(m.CodeMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCreations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCreations = append(m.ContractCreations, ContractCreation{})
+			if err := m.ContractCreations[len(m.ContractCreations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstalledPreinstalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstalledPreinstalls = append(m.InstalledPreinstalls, Preinstall{})
+			if err := m.InstalledPreinstalls[len(m.InstalledPreinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,6 +28,11 @@ const (
 	prefixParams
 	prefixCodeHash
 	prefixEvmCoinInfo
+	prefixContractABI
+	prefixForkSchedule
+	prefixCodeMetadata
+	prefixContractCreation
+	prefixPreinstall
 )
 
 // prefix bytes for the EVM transient store
@@ -40,11 +45,16 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode        = []byte{prefixCode}
-	KeyPrefixStorage     = []byte{prefixStorage}
-	KeyPrefixParams      = []byte{prefixParams}
-	KeyPrefixCodeHash    = []byte{prefixCodeHash}
-	KeyPrefixEvmCoinInfo = []byte{prefixEvmCoinInfo}
+	KeyPrefixCode             = []byte{prefixCode}
+	KeyPrefixStorage          = []byte{prefixStorage}
+	KeyPrefixParams           = []byte{prefixParams}
+	KeyPrefixCodeHash         = []byte{prefixCodeHash}
+	KeyPrefixEvmCoinInfo      = []byte{prefixEvmCoinInfo}
+	KeyPrefixContractABI      = []byte{prefixContractABI}
+	KeyPrefixForkSchedule     = []byte{prefixForkSchedule}
+	KeyPrefixCodeMetadata     = []byte{prefixCodeMetadata}
+	KeyPrefixContractCreation = []byte{prefixContractCreation}
+	KeyPrefixPreinstall       = []byte{prefixPreinstall}
 )
 
 // Transient Store key prefixes
@@ -64,3 +74,32 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// ContractABIKey defines the key under which the ABI of a registered contract
+// is stored.
+func ContractABIKey(address common.Address) []byte {
+	return append(KeyPrefixContractABI, address.Bytes()...)
+}
+
+// ForkScheduleKey defines the key under which the schedule of an Ethereum hard
+// fork is stored.
+func ForkScheduleKey(fork string) []byte {
+	return append(KeyPrefixForkSchedule, []byte(fork)...)
+}
+
+// CodeMetadataKey defines the key under which the metadata of a code is stored.
+func CodeMetadataKey(codeHash common.Hash) []byte {
+	return append(KeyPrefixCodeMetadata, codeHash.Bytes()...)
+}
+
+// ContractCreationKey defines the key under which the deployment record of a
+// contract is stored.
+func ContractCreationKey(address common.Address) []byte {
+	return append(KeyPrefixContractCreation, address.Bytes()...)
+}
+
+// PreinstallKey defines the key under which the registry entry of a
+// preinstalled contract is stored.
+func PreinstallKey(address common.Address) []byte {
+	return append(KeyPrefixPreinstall, address.Bytes()...)
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	protov2 "google.golang.org/protobuf/proto"
 
 	evmapi "github.com/cosmos/evm/api/cosmos/evm/vm/v1"
+	"github.com/cosmos/evm/utils"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -359,3 +361,104 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check for the provided contract ABIs.
+func (m *MsgRegisterContractABIs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.ContractABIs) == 0 {
+		return errorsmod.Wrap(ErrInvalidContractABI, "no contract abis provided")
+	}
+
+	seen := make(map[common.Address]bool, len(m.ContractABIs))
+	for _, contractABI := range m.ContractABIs {
+		if err := contractABI.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidContractABI, err.Error())
+		}
+
+		address := common.HexToAddress(contractABI.Address)
+		if seen[address] {
+			return errorsmod.Wrapf(ErrInvalidContractABI, "duplicated contract address %s", contractABI.Address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check for the provided fork schedule.
+func (m *MsgScheduleEVMFork) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	schedule := NewEVMForkSchedule(m.Fork, m.ActivationTime)
+	if schedule.ActivationTime == 0 {
+		// a zero activation time cancels the pending schedule of the fork
+		return schedule.ValidateFork()
+	}
+	return schedule.Validate()
+}
+
+// ValidateBasic does a sanity check for the provided code metadata.
+func (m *MsgRegisterCodeMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := utils.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(ErrInvalidCodeMetadata, err.Error())
+	}
+
+	if len(m.RuntimeBytecode) == 0 {
+		return errorsmod.Wrap(ErrInvalidCodeMetadata, "runtime bytecode cannot be empty")
+	}
+
+	if len(m.CreationBytecode) == 0 && len(m.ConstructorArguments) > 0 {
+		return errorsmod.Wrap(ErrInvalidCodeMetadata, "constructor arguments provided without creation bytecode")
+	}
+
+	metadata := m.CodeMetadata()
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidCodeMetadata, err.Error())
+	}
+	if err := metadata.ValidateCode(m.RuntimeBytecode); err != nil {
+		return errorsmod.Wrap(ErrInvalidCodeMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// CodeMetadata returns the code metadata submitted by the message. The code
+// hash is computed from the provided runtime bytecode.
+func (m *MsgRegisterCodeMetadata) CodeMetadata() CodeMetadata {
+	return CodeMetadata{
+		CodeHash:        crypto.Keccak256Hash(m.RuntimeBytecode).Hex(),
+		CompilerVersion: m.CompilerVersion,
+		Abi:             m.Abi,
+		SourceHash:      m.SourceHash,
+		Submitter:       m.Sender,
+		ContractAddress: common.HexToAddress(m.ContractAddress).Hex(),
+	}
+}
+
+// ValidateBasic does a sanity check for the provided contract addresses.
+func (m *MsgRemoveContractABIs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Addresses) == 0 {
+		return errorsmod.Wrap(ErrInvalidContractABI, "no contract addresses provided")
+	}
+
+	for _, address := range m.Addresses {
+		if err := utils.ValidateAddress(address); err != nil {
+			return errorsmod.Wrap(ErrInvalidContractABI, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
-var DefaultPreinstalls = []Preinstall{
+// DefaultPreinstalls are the contracts preinstalled in the default genesis
+// state, including the ERC4337Preinstalls.
+var DefaultPreinstalls = append([]Preinstall{
 	{
 		Name:    "Create2",
 		Address: "0x4e59b44847b379578588920ca78fbf26c0b4956c",
@@ -36,7 +38,7 @@ var DefaultPreinstalls = []Preinstall{
 		Address: params.HistoryStorageAddress.String(),
 		Code:    common.Bytes2Hex(params.HistoryStorageCode),
 	},
-}
+}, ERC4337Preinstalls...)
 
 // Validate performs basic validation checks on the Preinstall
 func (p Preinstall) Validate() error {
@@ -63,10 +65,44 @@ func (p Preinstall) Validate() error {
 	}
 
 	// Check if Code has Empty Code Hash
-	codeHash := crypto.Keccak256Hash(common.FromHex(p.Code)).Bytes()
-	if IsEmptyCodeHash(codeHash) {
+	codeHash := crypto.Keccak256Hash(common.FromHex(p.Code))
+	if IsEmptyCodeHash(codeHash.Bytes()) {
 		return fmt.Errorf("preinstall code %q has empty code hash", p.Code)
 	}
 
+	// Check that the code matches the expected code hash, if any
+	if p.CodeHash != "" {
+		if err := validateHexHash(p.CodeHash); err != nil {
+			return fmt.Errorf("preinstall code hash is invalid: %w", err)
+		}
+		if common.HexToHash(p.CodeHash) != codeHash {
+			return fmt.Errorf("preinstall code hash %s does not match the code hash %s", p.CodeHash, codeHash.Hex())
+		}
+	}
+
+	return nil
+}
+
+// RegistryEntry returns the representation of the preinstall stored in the
+// preinstall registry. The code is replaced by its keccak256 hash, since it
+// is already part of the EVM state.
+func (p Preinstall) RegistryEntry() Preinstall {
+	p.Address = common.HexToAddress(p.Address).Hex()
+	p.CodeHash = crypto.Keccak256Hash(common.FromHex(p.Code)).Hex()
+	p.Code = ""
+	return p
+}
+
+// ValidateRegistryEntry performs a stateless validation of a preinstall
+// registry entry.
+func (p Preinstall) ValidateRegistryEntry() error {
+	if !common.IsHexAddress(p.Address) {
+		return fmt.Errorf("preinstall address %q is not a valid hex address", p.Address)
+	}
+
+	if err := validateHexHash(p.CodeHash); err != nil {
+		return fmt.Errorf("preinstall code hash is invalid: %w", err)
+	}
+
 	return nil
 }
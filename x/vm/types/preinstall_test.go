@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -120,6 +122,37 @@ func TestPreinstall_Validate(t *testing.T) {
 			},
 			errorMsg: "preinstall code \"0x\" has empty code hash",
 		},
+		{
+			name: "valid preinstall with expected code hash",
+			preinstall: Preinstall{
+				Name:     "Test Contract",
+				Address:  "0x1234567890123456789012345678901234567890",
+				Code:     "0x608060405234801561001057600080fd5b50",
+				Version:  "1.0.0",
+				CodeHash: crypto.Keccak256Hash(common.FromHex("0x608060405234801561001057600080fd5b50")).Hex(),
+			},
+			errorMsg: "",
+		},
+		{
+			name: "invalid code hash - mismatch",
+			preinstall: Preinstall{
+				Name:     "Test Contract",
+				Address:  "0x1234567890123456789012345678901234567890",
+				Code:     "0x608060405234801561001057600080fd5b50",
+				CodeHash: common.HexToHash("0x01").Hex(),
+			},
+			errorMsg: "does not match the code hash",
+		},
+		{
+			name: "invalid code hash - malformed",
+			preinstall: Preinstall{
+				Name:     "Test Contract",
+				Address:  "0x1234567890123456789012345678901234567890",
+				Code:     "0x608060405234801561001057600080fd5b50",
+				CodeHash: "0x01",
+			},
+			errorMsg: "preinstall code hash is invalid",
+		},
 		{
 			name: "valid preinstall with empty name (name not validated)",
 			preinstall: Preinstall{
@@ -153,3 +186,29 @@ func TestDefaultPreinstalls_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestERC4337Preinstalls_Validate(t *testing.T) {
+	for _, preinstall := range ERC4337Preinstalls {
+		t.Run(preinstall.Name, func(t *testing.T) {
+			require.NoError(t, preinstall.Validate())
+			require.NotEmpty(t, preinstall.Version)
+			require.NotEmpty(t, preinstall.CodeHash)
+		})
+	}
+}
+
+func TestPreinstall_RegistryEntry(t *testing.T) {
+	preinstall := Preinstall{
+		Name:    "Test Contract",
+		Address: "0xabcdef1234567890123456789012345678901234",
+		Code:    "0x608060405234801561001057600080fd5b50",
+		Version: "1.0.0",
+	}
+
+	entry := preinstall.RegistryEntry()
+	require.NoError(t, entry.ValidateRegistryEntry())
+	require.Equal(t, common.HexToAddress(preinstall.Address).Hex(), entry.Address)
+	require.Equal(t, crypto.Keccak256Hash(common.FromHex(preinstall.Code)).Hex(), entry.CodeHash)
+	require.Empty(t, entry.Code)
+	require.Equal(t, preinstall.Version, entry.Version)
+}